@@ -4,9 +4,14 @@ import (
 	"catalyst-players/internal/domain/entities"
 	"catalyst-players/internal/infrastructure/database"
 	"catalyst-players/internal/presentation/routes"
+	"context"
+	"errors"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 )
 
 func main() {
@@ -27,13 +32,38 @@ func main() {
 		&entities.Season{},
 		&entities.Match{},
 		&entities.MatchPlayer{},
+		&entities.RuleSet{},
+		&entities.MatchEvent{},
+		&entities.WebhookSubscription{},
+		&entities.WebhookDelivery{},
+		&entities.PlayerRegistration{},
+		&entities.TransferWindow{},
+		&entities.PromotionRule{},
+		&entities.TeamMovement{},
+		&entities.Group{},
+		&entities.Tie{},
+		&entities.PenaltyKick{},
+		&entities.AdministrativeDecision{},
+		&entities.TeamSanction{},
+		&entities.TeamRating{},
 	)
 	if err != nil {
 		log.Fatalf("Failed to migrate database: %v", err)
 	}
 
 	// Setup routes
-	router := routes.SetupRoutes(db)
+	router, webhookService := routes.SetupRoutes(db)
+
+	// Stop background work on SIGINT or SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Deliver partner webhooks until shutdown
+	workerDone := make(chan struct{})
+	go func() {
+		defer close(workerDone)
+		webhookService.Run(ctx, 15*time.Second)
+	}()
 
 	// Get server port from environment
 	port := os.Getenv("SERVER_PORT")
@@ -42,8 +72,21 @@ func main() {
 	}
 
 	// Start server
-	log.Printf("Server starting on port %s", port)
-	if err := http.ListenAndServe(":"+port, router); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+	server := &http.Server{Addr: ":" + port, Handler: router}
+	go func() {
+		log.Printf("Server starting on port %s", port)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Failed to start server: %v", err)
+		}
+	}()
+
+	// Wait for a shutdown signal, then drain requests and the webhook worker
+	<-ctx.Done()
+	log.Printf("Server shutting down")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Failed to shut down server: %v", err)
 	}
+	<-workerDone
 }
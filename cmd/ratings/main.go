@@ -0,0 +1,39 @@
+// Command ratings recomputes the rating of every team from scratch by replaying all
+// finished matches in the order they were played. The rating parameters are read from
+// the RATING_* environment variables.
+package main
+
+import (
+	"catalyst-players/internal/application/services"
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/infrastructure/database"
+	"catalyst-players/internal/infrastructure/repositories"
+	"log"
+)
+
+func main() {
+	// Initialize database connection
+	dbConfig := database.NewConfig()
+	db, err := database.Connect(dbConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+
+	if err := db.AutoMigrate(&entities.TeamRating{}); err != nil {
+		log.Fatalf("Failed to migrate database: %v", err)
+	}
+
+	ratingService := services.NewRatingService(
+		repositories.NewTeamRatingRepositoryImpl(db),
+		repositories.NewMatchRepositoryImpl(db),
+		repositories.NewSeasonRepositoryImpl(db),
+		repositories.NewTeamRepositoryImpl(db),
+		services.RatingConfigFromEnv(),
+	)
+
+	rated, err := ratingService.Recompute()
+	if err != nil {
+		log.Fatalf("Failed to recompute ratings: %v", err)
+	}
+	log.Printf("Recomputed team ratings from %d matches", rated)
+}
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"time"
+)
+
+// MockAdministrativeDecisionRepository is an in-memory implementation of AdministrativeDecisionRepository for testing
+type MockAdministrativeDecisionRepository struct {
+	matchRepo *MockMatchRepository
+	decisions []entities.AdministrativeDecision
+}
+
+// NewMockAdministrativeDecisionRepository creates a new mock administrative decision repository
+// that saves the settled matches in matchRepo
+func NewMockAdministrativeDecisionRepository(matchRepo *MockMatchRepository) *MockAdministrativeDecisionRepository {
+	return &MockAdministrativeDecisionRepository{matchRepo: matchRepo}
+}
+
+func (m *MockAdministrativeDecisionRepository) Apply(match *entities.Match, decision *entities.AdministrativeDecision) error {
+	if err := m.matchRepo.SaveResult(match, false); err != nil {
+		return err
+	}
+	stored := m.matchRepo.matches[match.ID]
+	stored.AdministrativeResult, stored.AdministrativeReason = match.AdministrativeResult, match.AdministrativeReason
+
+	decision.ID = uint(len(m.decisions) + 1)
+	decision.AppliedAt = time.Now()
+	m.decisions = append(m.decisions, *decision)
+	return nil
+}
+
+func (m *MockAdministrativeDecisionRepository) GetByMatchID(matchID uint) ([]entities.AdministrativeDecision, error) {
+	decisions := make([]entities.AdministrativeDecision, 0)
+	for _, decision := range m.decisions {
+		if decision.MatchID == matchID {
+			decisions = append(decisions, decision)
+		}
+	}
+	return decisions, nil
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrAdministrativeResult is returned when the score or the timeline of a match settled with
+// an administrative result is changed; only a new administrative decision replaces that result
+var ErrAdministrativeResult = errors.New("the result of this match was decided off the pitch and only changes through a new administrative decision")
+
+// AdministrativeResultRequest describes a forfeit, walkover or awarded result. Without
+// scores the winner is given the awarded goals of the season rules and the loser none.
+type AdministrativeResultRequest struct {
+	Result       entities.AdministrativeResult `json:"result"`
+	WinnerTeamID uint                          `json:"winner_team_id"`
+	HomeScore    *int                          `json:"home_score"`
+	AwayScore    *int                          `json:"away_score"`
+	Reason       string                        `json:"reason"`
+	AppliedBy    string                        `json:"applied_by"`
+}
+
+// AdministrativeResultService settles matches with results decided off the pitch. Those
+// matches count in the leaderboard but not in the player statistics of the season.
+type AdministrativeResultService struct {
+	matchRepo      repositories.MatchRepository
+	decisionRepo   repositories.AdministrativeDecisionRepository
+	matchService   *MatchService
+	ruleSetService *RuleSetService
+}
+
+// NewAdministrativeResultService creates a new administrative result service instance
+func NewAdministrativeResultService(matchRepo repositories.MatchRepository, decisionRepo repositories.AdministrativeDecisionRepository, matchService *MatchService, ruleSetService *RuleSetService) *AdministrativeResultService {
+	return &AdministrativeResultService{
+		matchRepo:      matchRepo,
+		decisionRepo:   decisionRepo,
+		matchService:   matchService,
+		ruleSetService: ruleSetService,
+	}
+}
+
+// ApplyResult finishes a match with an administrative result, settles its points and
+// records who applied it. A result already applied can be replaced by a new decision.
+func (s *AdministrativeResultService) ApplyResult(matchID uint, request AdministrativeResultRequest) (*entities.Match, error) {
+	if matchID == 0 {
+		return nil, errors.New("invalid match ID")
+	}
+
+	if !request.Result.IsValid() {
+		return nil, fmt.Errorf("unknown administrative result %q", request.Result)
+	}
+
+	request.Reason = strings.TrimSpace(request.Reason)
+	if request.Reason == "" {
+		return nil, errors.New("reason is required")
+	}
+
+	request.AppliedBy = strings.TrimSpace(request.AppliedBy)
+	if request.AppliedBy == "" {
+		return nil, errors.New("applied by is required")
+	}
+
+	match, err := s.matchRepo.GetByID(matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	if matchStatus(match).IsLive() {
+		return nil, errors.New("a live match must be finished or abandoned before an administrative result is applied")
+	}
+
+	homeScore, awayScore, err := s.awardedScore(match, request)
+	if err != nil {
+		return nil, err
+	}
+
+	previousStatus := match.Status
+	match.Status = string(entities.MatchStatusFinished)
+	match.AdministrativeResult = request.Result
+	match.AdministrativeReason = request.Reason
+	match.HomeTeamScore = &homeScore
+	match.AwayTeamScore = &awayScore
+	if match.FinishedAt == nil {
+		now := time.Now()
+		match.FinishedAt = &now
+	}
+	if err := s.matchService.awardPoints(match); err != nil {
+		return nil, err
+	}
+
+	// The settled match and the decision are saved together, and the hooks only run once both are stored
+	if err := s.decisionRepo.Apply(match, &entities.AdministrativeDecision{
+		MatchID:      match.ID,
+		Result:       request.Result,
+		WinnerTeamID: request.WinnerTeamID,
+		HomeScore:    homeScore,
+		AwayScore:    awayScore,
+		Reason:       request.Reason,
+		AppliedBy:    request.AppliedBy,
+	}); err != nil {
+		return nil, err
+	}
+
+	if match.Status != previousStatus {
+		s.matchService.notifyUpdated(entities.MatchUpdateStatus, match)
+	}
+	s.matchService.notifyUpdated(entities.MatchUpdateScore, match)
+	s.matchService.notifyFinished(match)
+
+	return s.matchRepo.GetByID(match.ID)
+}
+
+// GetDecisions retrieves the administrative decisions applied to a match, oldest first
+func (s *AdministrativeResultService) GetDecisions(matchID uint) ([]entities.AdministrativeDecision, error) {
+	if matchID == 0 {
+		return nil, errors.New("invalid match ID")
+	}
+
+	return s.decisionRepo.GetByMatchID(matchID)
+}
+
+// awardedScore returns the score an administrative result settles the match with: the
+// requested one, which must make the winner win, or the awarded goals of the season rules
+func (s *AdministrativeResultService) awardedScore(match *entities.Match, request AdministrativeResultRequest) (int, int, error) {
+	if request.WinnerTeamID != match.HomeTeamID && request.WinnerTeamID != match.AwayTeamID {
+		return 0, 0, fmt.Errorf("winner team %d does not play the match", request.WinnerTeamID)
+	}
+	homeWins := request.WinnerTeamID == match.HomeTeamID
+
+	if request.HomeScore != nil || request.AwayScore != nil {
+		if request.HomeScore == nil || request.AwayScore == nil {
+			return 0, 0, errors.New("both scores are required")
+		}
+		homeScore, awayScore := *request.HomeScore, *request.AwayScore
+		if homeScore < 0 || awayScore < 0 {
+			return 0, 0, errors.New("scores cannot be negative")
+		}
+		if (homeWins && homeScore <= awayScore) || (!homeWins && awayScore <= homeScore) {
+			return 0, 0, errors.New("the score must give the win to the winner team")
+		}
+		return homeScore, awayScore, nil
+	}
+
+	rules, err := s.ruleSetService.ResolveForSeason(match.SeasonID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if homeWins {
+		return rules.AwardedScore(), 0, nil
+	}
+	return 0, rules.AwardedScore(), nil
+}
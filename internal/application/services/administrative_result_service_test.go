@@ -0,0 +1,255 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"testing"
+)
+
+// newTestAdministrativeResultService creates an administrative result service storing matches
+// in the returned repository, with the rules of season 1 resolved by the returned service
+func newTestAdministrativeResultService() (*AdministrativeResultService, *RuleSetService, *MockMatchRepository) {
+	matchRepo := NewMockMatchRepository()
+	ruleSetService, _, _ := newTestRuleSetService()
+	matchService := NewMatchService(matchRepo, ruleSetService)
+	return NewAdministrativeResultService(matchRepo, NewMockAdministrativeDecisionRepository(matchRepo), matchService, ruleSetService), ruleSetService, matchRepo
+}
+
+// TestAdministrativeResultService_ApplyResult tests that a walkover finishes the match with the
+// awarded score of the season rules, counts in the leaderboard and is kept in the audit trail
+func TestAdministrativeResultService_ApplyResult(t *testing.T) {
+	service, ruleSetService, matchRepo := newTestAdministrativeResultService()
+	rules := entities.DefaultRuleSet()
+	awardedGoals := 2
+	rules.AwardedGoals = &awardedGoals
+	if err := ruleSetService.SetSeasonRules(1, rules); err != nil {
+		t.Fatalf("SetSeasonRules() error = %v", err)
+	}
+
+	match := newFinishedMatch(1, 1, 2, 0, 0)
+	match.Status = string(entities.MatchStatusScheduled)
+	match.HomeTeamScore, match.AwayTeamScore = nil, nil
+	matchRepo.Create(match)
+
+	stored, err := service.ApplyResult(match.ID, AdministrativeResultRequest{
+		Result:       entities.AdministrativeWalkover,
+		WinnerTeamID: 2,
+		Reason:       "Home team did not turn up",
+		AppliedBy:    "competition office",
+	})
+	if err != nil {
+		t.Fatalf("ApplyResult() error = %v", err)
+	}
+
+	if stored.Status != string(entities.MatchStatusFinished) || stored.FinishedAt == nil || !stored.IsAdministrative() {
+		t.Errorf("match = %+v, want a finished walkover", stored)
+	}
+	if *stored.HomeTeamScore != 0 || *stored.AwayTeamScore != 2 {
+		t.Errorf("score = %d-%d, want the awarded 0-2", *stored.HomeTeamScore, *stored.AwayTeamScore)
+	}
+	if *stored.HomeTeamPoints != 0 || *stored.AwayTeamPoints != 3 {
+		t.Errorf("points = %d-%d, want 0-3", *stored.HomeTeamPoints, *stored.AwayTeamPoints)
+	}
+
+	leaderboardService := NewLeaderboardService(matchRepo, NewMockMatchPlayerRepository(matchRepo), ruleSetService, NewMockTeamSanctionRepository())
+	leaderboard, err := leaderboardService.GenerateLeaderboard(1)
+	if err != nil {
+		t.Fatalf("GenerateLeaderboard() error = %v", err)
+	}
+	assertOrder(t, leaderboard, 2, 1)
+
+	decisions, err := service.GetDecisions(match.ID)
+	if err != nil {
+		t.Fatalf("GetDecisions() error = %v", err)
+	}
+	if len(decisions) != 1 || decisions[0].AppliedBy != "competition office" || decisions[0].AwayScore != 2 {
+		t.Errorf("decisions = %+v, want the walkover applied by the competition office", decisions)
+	}
+
+	// An explicit score overrides the awarded goals
+	homeScore, awayScore := 0, 4
+	stored, err = service.ApplyResult(match.ID, AdministrativeResultRequest{
+		Result:       entities.AdministrativeAwarded,
+		WinnerTeamID: 2,
+		HomeScore:    &homeScore,
+		AwayScore:    &awayScore,
+		Reason:       "Appeal upheld",
+		AppliedBy:    "appeals board",
+	})
+	if err != nil {
+		t.Fatalf("ApplyResult() error = %v", err)
+	}
+	if *stored.AwayTeamScore != 4 || stored.AdministrativeResult != entities.AdministrativeAwarded {
+		t.Errorf("match = %+v, want an awarded 0-4", stored)
+	}
+	if decisions, _ := service.GetDecisions(match.ID); len(decisions) != 2 {
+		t.Errorf("got %d decisions, want both kept", len(decisions))
+	}
+}
+
+// TestAdministrativeResultService_ZeroAwardedGoals tests that rules awarding no goals are kept
+// instead of falling back to the default
+func TestAdministrativeResultService_ZeroAwardedGoals(t *testing.T) {
+	service, ruleSetService, matchRepo := newTestAdministrativeResultService()
+	rules := entities.DefaultRuleSet()
+	noGoals := 0
+	rules.AwardedGoals = &noGoals
+	if err := ruleSetService.SetSeasonRules(1, rules); err != nil {
+		t.Fatalf("SetSeasonRules() error = %v", err)
+	}
+
+	match := newFinishedMatch(1, 1, 2, 1, 1)
+	matchRepo.Create(match)
+
+	stored, err := service.ApplyResult(match.ID, AdministrativeResultRequest{
+		Result:       entities.AdministrativeAwarded,
+		WinnerTeamID: 1,
+		Reason:       "Match abandoned after a pitch invasion",
+		AppliedBy:    "competition office",
+	})
+	if err != nil {
+		t.Fatalf("ApplyResult() error = %v", err)
+	}
+	if *stored.HomeTeamScore != 0 || *stored.AwayTeamScore != 0 {
+		t.Errorf("score = %d-%d, want no goals awarded", *stored.HomeTeamScore, *stored.AwayTeamScore)
+	}
+}
+
+// TestAdministrativeResultService_HooksAfterDecision tests that the match hooks run once,
+// after both the settled match and the decision are stored
+func TestAdministrativeResultService_HooksAfterDecision(t *testing.T) {
+	service, _, matchRepo := newTestAdministrativeResultService()
+	match := newFinishedMatch(1, 1, 2, 1, 1)
+	matchRepo.Create(match)
+
+	finished, scores := 0, 0
+	service.matchService.OnMatchFinished(func(finishedMatch *entities.Match) error {
+		finished++
+		decisions, _ := service.GetDecisions(finishedMatch.ID)
+		stored, _ := matchRepo.GetByID(finishedMatch.ID)
+		if len(decisions) != 1 || !stored.IsAdministrative() || *stored.AwayTeamPoints != 3 {
+			t.Errorf("hook ran with %d decisions and match %+v, want the settled forfeit", len(decisions), stored)
+		}
+		return nil
+	})
+	service.matchService.OnMatchUpdated(func(update entities.MatchUpdate) {
+		if update.Type == entities.MatchUpdateScore {
+			scores++
+		}
+	})
+
+	if _, err := service.ApplyResult(match.ID, AdministrativeResultRequest{
+		Result:       entities.AdministrativeForfeit,
+		WinnerTeamID: 2,
+		Reason:       "Ineligible player fielded",
+		AppliedBy:    "disciplinary committee",
+	}); err != nil {
+		t.Fatalf("ApplyResult() error = %v", err)
+	}
+	if finished != 1 || scores != 1 {
+		t.Errorf("finished hooks ran %d times and score updates %d times, want once each", finished, scores)
+	}
+}
+
+// TestAdministrativeResultService_ScoreIsLocked tests that a score update cannot overwrite an
+// administrative result, while a new decision still replaces it
+func TestAdministrativeResultService_ScoreIsLocked(t *testing.T) {
+	service, _, matchRepo := newTestAdministrativeResultService()
+	match := newFinishedMatch(1, 1, 2, 1, 1)
+	matchRepo.Create(match)
+	request := AdministrativeResultRequest{
+		Result:       entities.AdministrativeForfeit,
+		WinnerTeamID: 2,
+		Reason:       "Ineligible player fielded",
+		AppliedBy:    "disciplinary committee",
+	}
+	if _, err := service.ApplyResult(match.ID, request); err != nil {
+		t.Fatalf("ApplyResult() error = %v", err)
+	}
+
+	if err := service.matchService.UpdateMatchScore(match.ID, 2, 0); !errors.Is(err, ErrAdministrativeResult) {
+		t.Errorf("UpdateMatchScore() error = %v, want ErrAdministrativeResult", err)
+	}
+	if err := service.matchService.UpdateMatchResult(match.ID, MatchResult{HomeScore: 2, AwayScore: 0}); !errors.Is(err, ErrAdministrativeResult) {
+		t.Errorf("UpdateMatchResult() error = %v, want ErrAdministrativeResult", err)
+	}
+	stored, _ := matchRepo.GetByID(match.ID)
+	if *stored.HomeTeamScore != 0 || *stored.AwayTeamScore != 3 || *stored.AwayTeamPoints != 3 {
+		t.Errorf("score = %d-%d, want the awarded 0-3 kept", *stored.HomeTeamScore, *stored.AwayTeamScore)
+	}
+
+	homeScore, awayScore := 0, 1
+	request.HomeScore, request.AwayScore = &homeScore, &awayScore
+	if stored, err := service.ApplyResult(match.ID, request); err != nil || *stored.AwayTeamScore != 1 {
+		t.Errorf("ApplyResult() again = %v, want the new decision applied", err)
+	}
+}
+
+// TestAdministrativeResultService_ApplyResultValidation tests that invalid requests leave the match untouched
+func TestAdministrativeResultService_ApplyResultValidation(t *testing.T) {
+	service, _, matchRepo := newTestAdministrativeResultService()
+	match := &entities.Match{SeasonID: 1, HomeTeamID: 1, AwayTeamID: 2}
+	matchRepo.Create(match)
+	live := &entities.Match{SeasonID: 1, HomeTeamID: 1, AwayTeamID: 2, Status: string(entities.MatchStatusInProgress)}
+	matchRepo.Create(live)
+
+	valid := AdministrativeResultRequest{Result: entities.AdministrativeForfeit, WinnerTeamID: 1, Reason: "Ineligible player", AppliedBy: "referee"}
+	draw := 1
+
+	tests := []struct {
+		name    string
+		matchID uint
+		modify  func(*AdministrativeResultRequest)
+	}{
+		{name: "unknown result", matchID: match.ID, modify: func(r *AdministrativeResultRequest) { r.Result = "replay" }},
+		{name: "missing reason", matchID: match.ID, modify: func(r *AdministrativeResultRequest) { r.Reason = " " }},
+		{name: "missing author", matchID: match.ID, modify: func(r *AdministrativeResultRequest) { r.AppliedBy = "" }},
+		{name: "winner not playing", matchID: match.ID, modify: func(r *AdministrativeResultRequest) { r.WinnerTeamID = 3 }},
+		{name: "one score", matchID: match.ID, modify: func(r *AdministrativeResultRequest) { r.HomeScore = &draw }},
+		{name: "score without a winner", matchID: match.ID, modify: func(r *AdministrativeResultRequest) { r.HomeScore, r.AwayScore = &draw, &draw }},
+		{name: "live match", matchID: live.ID, modify: func(r *AdministrativeResultRequest) {}},
+	}
+
+	for _, tt := range tests {
+		request := valid
+		tt.modify(&request)
+		if _, err := service.ApplyResult(tt.matchID, request); err == nil {
+			t.Errorf("ApplyResult() with %s should fail", tt.name)
+		}
+	}
+
+	if stored, _ := matchRepo.GetByID(match.ID); stored.IsAdministrative() || stored.HomeTeamScore != nil {
+		t.Errorf("match = %+v, want it untouched", stored)
+	}
+}
+
+// TestMatchPlayerService_GetPlayerStatsExcludesAdministrativeResults tests that the statistics
+// of a match settled off the pitch do not count for the players
+func TestMatchPlayerService_GetPlayerStatsExcludesAdministrativeResults(t *testing.T) {
+	service, _, matchRepo := newTestAdministrativeResultService()
+	played := newFinishedMatch(1, 1, 2, 2, 0)
+	matchRepo.Create(played)
+	forfeited := newFinishedMatch(1, 2, 1, 1, 1)
+	matchRepo.Create(forfeited)
+
+	matchPlayerRepo := NewMockMatchPlayerRepository(matchRepo)
+	matchPlayerRepo.Create(&entities.MatchPlayer{MatchID: played.ID, PlayerID: 9, TeamID: 1, Goals: 2})
+	matchPlayerRepo.Create(&entities.MatchPlayer{MatchID: forfeited.ID, PlayerID: 9, TeamID: 1, Goals: 1})
+
+	if _, err := service.ApplyResult(forfeited.ID, AdministrativeResultRequest{
+		Result:       entities.AdministrativeForfeit,
+		WinnerTeamID: 2,
+		Reason:       "Ineligible player fielded",
+		AppliedBy:    "disciplinary committee",
+	}); err != nil {
+		t.Fatalf("ApplyResult() error = %v", err)
+	}
+
+	stats, err := matchPlayerRepo.GetPlayerStats(9, 1)
+	if err != nil {
+		t.Fatalf("GetPlayerStats() error = %v", err)
+	}
+	if len(stats) != 1 || stats[0].MatchID != played.ID {
+		t.Errorf("stats = %+v, want only the played match", stats)
+	}
+}
@@ -0,0 +1,205 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrPlayerSuspended is returned when statistics are recorded for a player who is serving a ban
+var ErrPlayerSuspended = errors.New("player is suspended")
+
+// DisciplineService derives suspensions from the cards recorded in a season. Bans are not
+// stored: they are replayed from the match statistics so that corrected cards or results
+// are always reflected.
+type DisciplineService struct {
+	matchRepo       repositories.MatchRepository
+	matchPlayerRepo repositories.MatchPlayerRepository
+	ruleSetService  *RuleSetService
+}
+
+// NewDisciplineService creates a new discipline service instance
+func NewDisciplineService(matchRepo repositories.MatchRepository, matchPlayerRepo repositories.MatchPlayerRepository, ruleSetService *RuleSetService) *DisciplineService {
+	return &DisciplineService{
+		matchRepo:       matchRepo,
+		matchPlayerRepo: matchPlayerRepo,
+		ruleSetService:  ruleSetService,
+	}
+}
+
+// GetSeasonSuspensions retrieves every suspension of a season, served or not
+func (s *DisciplineService) GetSeasonSuspensions(seasonID uint) ([]entities.Suspension, error) {
+	if seasonID == 0 {
+		return nil, errors.New("invalid season ID")
+	}
+
+	return s.replay(seasonID, nil)
+}
+
+// GetPlayerSuspensions retrieves the suspensions of a player across all seasons they played in
+func (s *DisciplineService) GetPlayerSuspensions(playerID uint) ([]entities.Suspension, error) {
+	if playerID == 0 {
+		return nil, errors.New("invalid player ID")
+	}
+
+	stats, err := s.matchPlayerRepo.GetByPlayerID(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	seasonIDs := make([]uint, 0)
+	seenSeasons := make(map[uint]bool)
+	seenMatches := make(map[uint]bool)
+	for _, stat := range stats {
+		if seenMatches[stat.MatchID] {
+			continue
+		}
+		seenMatches[stat.MatchID] = true
+
+		match, err := s.matchRepo.GetByID(stat.MatchID)
+		if err != nil {
+			return nil, err
+		}
+		if !seenSeasons[match.SeasonID] {
+			seenSeasons[match.SeasonID] = true
+			seasonIDs = append(seasonIDs, match.SeasonID)
+		}
+	}
+	sort.Slice(seasonIDs, func(i, j int) bool { return seasonIDs[i] < seasonIDs[j] })
+
+	suspensions := make([]entities.Suspension, 0)
+	for _, seasonID := range seasonIDs {
+		seasonSuspensions, err := s.replay(seasonID, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, suspension := range seasonSuspensions {
+			if suspension.PlayerID == playerID {
+				suspensions = append(suspensions, suspension)
+			}
+		}
+	}
+
+	return suspensions, nil
+}
+
+// CheckEligibility returns ErrPlayerSuspended when the player still has a ban to serve
+// from the matches of the season played before the given match
+func (s *DisciplineService) CheckEligibility(playerID uint, match *entities.Match) error {
+	suspensions, err := s.replay(match.SeasonID, match)
+	if err != nil {
+		return err
+	}
+
+	for _, suspension := range suspensions {
+		if suspension.PlayerID == playerID && suspension.Active {
+			return fmt.Errorf("%w for this match: %d of %d matches served for %s",
+				ErrPlayerSuspended, suspension.Served, suspension.Matches, suspension.Reason)
+		}
+	}
+	return nil
+}
+
+// replay walks through the matches of a season in chronological order, books the cards of
+// each match and serves open bans whenever the team of a suspended player finishes a match.
+// When before is set, only the matches played before it are taken into account.
+func (s *DisciplineService) replay(seasonID uint, before *entities.Match) ([]entities.Suspension, error) {
+	rules, err := s.ruleSetService.ResolveForSeason(seasonID)
+	if err != nil {
+		return nil, err
+	}
+	yellowThreshold, yellowBan, redBan := rules.Discipline()
+
+	matches, err := s.matchRepo.GetBySeasonID(seasonID)
+	if err != nil {
+		return nil, err
+	}
+	sortMatchesChronologically(matches)
+
+	stats, err := s.matchPlayerRepo.GetBySeasonID(seasonID)
+	if err != nil {
+		return nil, err
+	}
+	statsByMatch := make(map[uint][]entities.MatchPlayer)
+	for _, stat := range stats {
+		statsByMatch[stat.MatchID] = append(statsByMatch[stat.MatchID], stat)
+	}
+
+	suspensions := make([]*entities.Suspension, 0)
+	yellowCards := make(map[uint]int)
+	book := func(stat entities.MatchPlayer, reason entities.SuspensionReason, length int) {
+		if length == 0 {
+			return // The rules do not ban players for these cards
+		}
+		suspensions = append(suspensions, &entities.Suspension{
+			PlayerID:       stat.PlayerID,
+			TeamID:         stat.TeamID,
+			SeasonID:       seasonID,
+			Reason:         reason,
+			TriggerMatchID: stat.MatchID,
+			Matches:        length,
+			ServedMatchIDs: make([]uint, 0),
+		})
+	}
+
+	for _, match := range matches {
+		if before != nil && !playedBefore(match, *before) {
+			break
+		}
+
+		// A finished match serves one match of the oldest open ban of each suspended player
+		if match.Status == string(entities.MatchStatusFinished) {
+			served := make(map[uint]bool)
+			for _, suspension := range suspensions {
+				if served[suspension.PlayerID] || suspension.Remaining() == 0 {
+					continue
+				}
+				if suspension.TeamID != match.HomeTeamID && suspension.TeamID != match.AwayTeamID {
+					continue
+				}
+				suspension.Served++
+				suspension.ServedMatchIDs = append(suspension.ServedMatchIDs, match.ID)
+				served[suspension.PlayerID] = true
+			}
+		}
+
+		bookings := statsByMatch[match.ID]
+		sort.Slice(bookings, func(i, j int) bool { return bookings[i].PlayerID < bookings[j].PlayerID })
+		for _, stat := range bookings {
+			if stat.YellowCard > 0 {
+				previous := yellowCards[stat.PlayerID]
+				yellowCards[stat.PlayerID] += stat.YellowCard
+				for i := previous / yellowThreshold; i < yellowCards[stat.PlayerID]/yellowThreshold; i++ {
+					book(stat, entities.SuspensionReasonYellowCards, yellowBan)
+				}
+			}
+			for i := 0; i < stat.RedCard; i++ {
+				book(stat, entities.SuspensionReasonRedCard, redBan)
+			}
+		}
+	}
+
+	result := make([]entities.Suspension, 0, len(suspensions))
+	for _, suspension := range suspensions {
+		suspension.Active = suspension.Remaining() > 0
+		result = append(result, *suspension)
+	}
+	return result, nil
+}
+
+// sortMatchesChronologically orders matches by date, using the ID for matches on the same date
+func sortMatchesChronologically(matches []entities.Match) {
+	sort.SliceStable(matches, func(i, j int) bool {
+		return playedBefore(matches[i], matches[j])
+	})
+}
+
+// playedBefore reports whether match a takes place before match b
+func playedBefore(a, b entities.Match) bool {
+	if !a.Date.Equal(b.Date) {
+		return a.Date.Before(b.Date)
+	}
+	return a.ID < b.ID
+}
@@ -0,0 +1,181 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"testing"
+	"time"
+)
+
+// disciplineFixture holds the repositories and services used by the discipline tests
+type disciplineFixture struct {
+	matchRepo          *MockMatchRepository
+	matchPlayerRepo    *MockMatchPlayerRepository
+	disciplineService  *DisciplineService
+	transferService    *TransferService
+	matchPlayerService *MatchPlayerService
+}
+
+// newDisciplineFixture creates a discipline service for season 1 with the given rules,
+// teams 1 to 4 enrolled and players 7 to 10 at team 1
+func newDisciplineFixture(t *testing.T, rules *entities.RuleSet) *disciplineFixture {
+	t.Helper()
+
+	matchRepo := NewMockMatchRepository()
+	matchPlayerRepo := NewMockMatchPlayerRepository(matchRepo)
+	ruleSetService, _, seasonRepo := newTestRuleSetService()
+	enrollTeams(seasonRepo, 1, 1, 2, 3, 4)
+	playerRepo := NewMockPlayerRepository()
+	for id := uint(7); id <= 10; id++ {
+		playerRepo.Create(&entities.Player{ID: id, TeamID: 1, Number: int(id)})
+	}
+	if rules != nil {
+		if err := ruleSetService.SetSeasonRules(1, rules); err != nil {
+			t.Fatalf("SetSeasonRules() error = %v", err)
+		}
+	}
+
+	disciplineService := NewDisciplineService(matchRepo, matchPlayerRepo, ruleSetService)
+	transferService := NewTransferService(NewMockPlayerRegistrationRepository(playerRepo), NewMockTransferWindowRepository(), playerRepo, seasonRepo)
+	return &disciplineFixture{
+		matchRepo:          matchRepo,
+		matchPlayerRepo:    matchPlayerRepo,
+		disciplineService:  disciplineService,
+		transferService:    transferService,
+		matchPlayerService: NewMatchPlayerService(matchPlayerRepo, matchRepo, seasonRepo, transferService, disciplineService),
+	}
+}
+
+// enrollTeams adds teams to a season through season_team
+func enrollTeams(seasonRepo *MockSeasonRepository, seasonID uint, teamIDs ...uint) {
+	season, _ := seasonRepo.GetByID(seasonID)
+	for _, teamID := range teamIDs {
+		season.Teams = append(season.Teams, entities.Team{ID: teamID, Name: teamName(teamID)})
+	}
+	seasonRepo.Update(season)
+}
+
+// playRound creates a finished match between teams 1 and 2 on the given day of March
+func (f *disciplineFixture) playRound(day int) *entities.Match {
+	match := newFinishedMatch(1, 1, 2, 1, 0)
+	match.Date = time.Date(2025, 3, day, 15, 0, 0, 0, time.UTC)
+	f.matchRepo.Create(match)
+	return match
+}
+
+// book records cards for a player of team 1
+func (f *disciplineFixture) book(t *testing.T, match *entities.Match, playerID uint, yellow, red int) {
+	t.Helper()
+
+	if err := f.matchPlayerService.CreateMatchPlayer(&entities.MatchPlayer{
+		MatchID:    match.ID,
+		TeamID:     1,
+		PlayerID:   playerID,
+		YellowCard: yellow,
+		RedCard:    red,
+	}); err != nil {
+		t.Fatalf("CreateMatchPlayer() error = %v", err)
+	}
+}
+
+// TestDisciplineService_YellowCardThreshold tests that reaching the yellow card threshold
+// suspends a player for the configured number of matches
+func TestDisciplineService_YellowCardThreshold(t *testing.T) {
+	rules := entities.DefaultRuleSet()
+	threshold, ban := 3, 2
+	rules.YellowCardThreshold = &threshold
+	rules.YellowCardBanMatches = &ban
+	f := newDisciplineFixture(t, rules)
+
+	for day := 1; day <= 3; day++ {
+		f.book(t, f.playRound(day), 10, 1, 0)
+	}
+
+	suspensions, err := f.disciplineService.GetPlayerSuspensions(10)
+	if err != nil {
+		t.Fatalf("GetPlayerSuspensions() error = %v", err)
+	}
+	if len(suspensions) != 1 {
+		t.Fatalf("got %d suspensions, want 1", len(suspensions))
+	}
+	suspension := suspensions[0]
+	if suspension.Reason != entities.SuspensionReasonYellowCards || suspension.Matches != 2 || !suspension.Active {
+		t.Errorf("suspension = %+v, want an active 2 match ban for yellow cards", suspension)
+	}
+
+	f.playRound(4)
+	f.playRound(5)
+	suspensions, _ = f.disciplineService.GetSeasonSuspensions(1)
+	if suspensions[0].Active || suspensions[0].Served != 2 || len(suspensions[0].ServedMatchIDs) != 2 {
+		t.Errorf("suspension = %+v, want served after two more matches", suspensions[0])
+	}
+}
+
+// TestDisciplineService_RedCardServedAsTeamPlays tests that a red card ban only counts down
+// when the player's team finishes a match
+func TestDisciplineService_RedCardServedAsTeamPlays(t *testing.T) {
+	f := newDisciplineFixture(t, nil)
+	f.book(t, f.playRound(1), 7, 0, 1)
+
+	other := newFinishedMatch(1, 3, 4, 2, 2)
+	other.Date = time.Date(2025, 3, 2, 15, 0, 0, 0, time.UTC)
+	f.matchRepo.Create(other)
+
+	scheduled := f.playRound(3)
+	scheduled.Status = string(entities.MatchStatusScheduled)
+	f.matchRepo.Update(scheduled)
+
+	suspensions, err := f.disciplineService.GetSeasonSuspensions(1)
+	if err != nil {
+		t.Fatalf("GetSeasonSuspensions() error = %v", err)
+	}
+	if len(suspensions) != 1 || suspensions[0].Reason != entities.SuspensionReasonRedCard || !suspensions[0].Active {
+		t.Fatalf("suspensions = %+v, want one active red card ban", suspensions)
+	}
+
+	// The ban covers the scheduled match, but not the one after it
+	if err := f.disciplineService.CheckEligibility(7, scheduled); !errors.Is(err, ErrPlayerSuspended) {
+		t.Errorf("CheckEligibility() for the next match error = %v, want ErrPlayerSuspended", err)
+	}
+	scheduled.Status = string(entities.MatchStatusFinished)
+	f.matchRepo.Update(scheduled)
+	if err := f.disciplineService.CheckEligibility(7, f.playRound(4)); err != nil {
+		t.Errorf("CheckEligibility() after serving the ban error = %v", err)
+	}
+}
+
+// TestDisciplineService_NoRedCardBan tests that a league can set a red card ban of zero
+// matches instead of falling back to the default ban
+func TestDisciplineService_NoRedCardBan(t *testing.T) {
+	rules := entities.DefaultRuleSet()
+	rules.RedCardBanMatches = new(int)
+	f := newDisciplineFixture(t, rules)
+	f.book(t, f.playRound(1), 7, 0, 1)
+
+	suspensions, err := f.disciplineService.GetSeasonSuspensions(1)
+	if err != nil {
+		t.Fatalf("GetSeasonSuspensions() error = %v", err)
+	}
+	if len(suspensions) != 0 {
+		t.Errorf("suspensions = %+v, want none", suspensions)
+	}
+	if err := f.disciplineService.CheckEligibility(7, f.playRound(2)); err != nil {
+		t.Errorf("CheckEligibility() error = %v", err)
+	}
+}
+
+// TestMatchPlayerService_CreateRejectsSuspendedPlayer tests that statistics cannot be recorded
+// for a player in a match they are suspended for
+func TestMatchPlayerService_CreateRejectsSuspendedPlayer(t *testing.T) {
+	f := newDisciplineFixture(t, nil)
+	f.book(t, f.playRound(1), 7, 0, 1)
+	next := f.playRound(2)
+
+	err := f.matchPlayerService.CreateMatchPlayer(&entities.MatchPlayer{MatchID: next.ID, TeamID: 1, PlayerID: 7, Goals: 1})
+	if !errors.Is(err, ErrPlayerSuspended) {
+		t.Fatalf("CreateMatchPlayer() error = %v, want ErrPlayerSuspended", err)
+	}
+
+	f.book(t, next, 8, 0, 0)
+	f.book(t, f.playRound(3), 7, 0, 0)
+}
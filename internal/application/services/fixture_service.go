@@ -0,0 +1,188 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// FixtureOptions holds the parameters used to generate a season schedule
+type FixtureOptions struct {
+	DoubleRoundRobin bool `json:"double_round_robin"`
+	StadiumID        uint `json:"stadium_id"`
+	DryRun           bool `json:"dry_run"`
+}
+
+// fixturePairing is a single home/away pairing inside a round
+type fixturePairing struct {
+	HomeTeamID uint
+	AwayTeamID uint
+}
+
+// FixtureService handles the generation of round-robin schedules for seasons
+type FixtureService struct {
+	seasonRepo repositories.SeasonRepository
+	matchRepo  repositories.MatchRepository
+	groupRepo  repositories.GroupRepository
+}
+
+// NewFixtureService creates a new fixture service instance
+func NewFixtureService(seasonRepo repositories.SeasonRepository, matchRepo repositories.MatchRepository, groupRepo repositories.GroupRepository) *FixtureService {
+	return &FixtureService{
+		seasonRepo: seasonRepo,
+		matchRepo:  matchRepo,
+		groupRepo:  groupRepo,
+	}
+}
+
+// GenerateFixtures builds a round-robin schedule for the teams enrolled in a season, or one
+// per group when the season has a group stage. Unless DryRun is set, the generated matches
+// are persisted in a single transaction.
+func (s *FixtureService) GenerateFixtures(seasonID uint, opts FixtureOptions) ([]entities.Match, error) {
+	if seasonID == 0 {
+		return nil, errors.New("invalid season ID")
+	}
+
+	if opts.StadiumID == 0 {
+		return nil, errors.New("stadium ID is required")
+	}
+
+	season, err := s.seasonRepo.GetWithTeams(seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(season.Teams) < 2 {
+		return nil, errors.New("season needs at least two enrolled teams")
+	}
+
+	existing, err := s.matchRepo.GetByStage(seasonID, entities.MatchStageRegular)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return nil, errors.New("season already has regular stage matches")
+	}
+
+	groups, err := s.groupRepo.GetBySeasonID(seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	stadiumFor := func(uint) uint { return opts.StadiumID }
+	var matches []entities.Match
+	if len(groups) == 0 {
+		matches = scheduleRoundRobin(season, sortedTeamIDs(season.Teams), opts.DoubleRoundRobin, stadiumFor)
+	}
+	for _, group := range groups {
+		if len(group.Teams) < 2 {
+			return nil, fmt.Errorf("%s needs at least two teams", group.Name)
+		}
+		matches = append(matches, scheduleRoundRobin(season, sortedTeamIDs(group.Teams), opts.DoubleRoundRobin, stadiumFor)...)
+	}
+
+	if opts.DryRun {
+		return matches, nil
+	}
+
+	if err := s.matchRepo.CreateBatch(matches); err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+// scheduleRoundRobin builds the regular stage matches of a season for the given teams, spread
+// over the season dates. stadiumFor returns the stadium of the matches hosted by a team.
+func scheduleRoundRobin(season *entities.Season, teamIDs []uint, double bool, stadiumFor func(homeTeamID uint) uint) []entities.Match {
+	rounds := roundRobinRounds(teamIDs, double)
+	dates := spreadRoundDates(season.StartsAt, season.EndsAt, len(rounds))
+
+	matches := make([]entities.Match, 0, len(rounds)*len(rounds[0]))
+	for i, round := range rounds {
+		for _, pairing := range round {
+			matches = append(matches, entities.Match{
+				HomeTeamID: pairing.HomeTeamID,
+				AwayTeamID: pairing.AwayTeamID,
+				SeasonID:   season.ID,
+				StadiumID:  stadiumFor(pairing.HomeTeamID),
+				Date:       dates[i],
+				Stage:      entities.MatchStageRegular,
+				Status:     string(entities.MatchStatusScheduled),
+				Round:      i + 1,
+			})
+		}
+	}
+	return matches
+}
+
+// roundRobinRounds schedules every team against every other team using the circle method.
+// The first team stays fixed while the rest rotate; home and away are alternated so that
+// no team plays more than one extra home game. With an odd number of teams one team rests
+// each round. A double round robin appends the mirrored rounds with home and away swapped.
+func roundRobinRounds(teamIDs []uint, double bool) [][]fixturePairing {
+	teams := append([]uint(nil), teamIDs...)
+	if len(teams)%2 == 1 {
+		// 0 marks the bye; keeping it fixed lets the remaining teams rotate through it
+		teams = append([]uint{0}, teams...)
+	}
+
+	n := len(teams)
+	rounds := make([][]fixturePairing, 0, n-1)
+	for r := 0; r < n-1; r++ {
+		round := make([]fixturePairing, 0, n/2)
+		for i := 0; i < n/2; i++ {
+			home, away := teams[i], teams[n-1-i]
+			if (i == 0 && r%2 == 1) || (i > 0 && i%2 == 1) {
+				home, away = away, home
+			}
+			if home == 0 || away == 0 {
+				continue
+			}
+			round = append(round, fixturePairing{HomeTeamID: home, AwayTeamID: away})
+		}
+		rounds = append(rounds, round)
+
+		// Rotate every team except the first one position clockwise
+		last := teams[n-1]
+		copy(teams[2:], teams[1:n-1])
+		teams[1] = last
+	}
+
+	if double {
+		firstHalf := len(rounds)
+		for r := 0; r < firstHalf; r++ {
+			mirrored := make([]fixturePairing, 0, len(rounds[r]))
+			for _, pairing := range rounds[r] {
+				mirrored = append(mirrored, fixturePairing{HomeTeamID: pairing.AwayTeamID, AwayTeamID: pairing.HomeTeamID})
+			}
+			rounds = append(rounds, mirrored)
+		}
+	}
+
+	return rounds
+}
+
+// spreadRoundDates distributes the rounds evenly between the season start and end dates.
+// Spacing is rounded down to whole days whenever the season is long enough.
+func spreadRoundDates(startsAt, endsAt time.Time, rounds int) []time.Time {
+	dates := make([]time.Time, rounds)
+	if rounds == 0 {
+		return dates
+	}
+
+	var step time.Duration
+	if rounds > 1 && endsAt.After(startsAt) {
+		step = endsAt.Sub(startsAt) / time.Duration(rounds-1)
+		if step >= 24*time.Hour {
+			step = step.Truncate(24 * time.Hour)
+		}
+	}
+
+	for i := range dates {
+		dates[i] = startsAt.Add(time.Duration(i) * step)
+	}
+	return dates
+}
@@ -0,0 +1,107 @@
+package services
+
+import (
+	"testing"
+	"time"
+)
+
+// TestRoundRobinRounds tests that every team meets every other team exactly once per leg
+func TestRoundRobinRounds(t *testing.T) {
+	tests := []struct {
+		name       string
+		teams      int
+		double     bool
+		wantRounds int
+	}{
+		{name: "Even number of teams", teams: 16, double: false, wantRounds: 15},
+		{name: "Odd number of teams", teams: 5, double: false, wantRounds: 5},
+		{name: "Seven teams", teams: 7, double: false, wantRounds: 7},
+		{name: "Double round robin", teams: 6, double: true, wantRounds: 10},
+		{name: "Two teams", teams: 2, double: true, wantRounds: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teamIDs := make([]uint, tt.teams)
+			for i := range teamIDs {
+				teamIDs[i] = uint(i + 1)
+			}
+
+			rounds := roundRobinRounds(teamIDs, tt.double)
+			if len(rounds) != tt.wantRounds {
+				t.Fatalf("roundRobinRounds() returned %d rounds, want %d", len(rounds), tt.wantRounds)
+			}
+
+			meetings := make(map[[2]uint]int)
+			homeGames := make(map[uint]int)
+			for r, round := range rounds {
+				playing := make(map[uint]bool)
+				for _, pairing := range round {
+					if playing[pairing.HomeTeamID] || playing[pairing.AwayTeamID] {
+						t.Fatalf("round %d schedules a team twice", r+1)
+					}
+					playing[pairing.HomeTeamID] = true
+					playing[pairing.AwayTeamID] = true
+					meetings[[2]uint{pairing.HomeTeamID, pairing.AwayTeamID}]++
+					homeGames[pairing.HomeTeamID]++
+				}
+			}
+
+			for _, home := range teamIDs {
+				for _, away := range teamIDs {
+					if home == away {
+						continue
+					}
+					direct := meetings[[2]uint{home, away}]
+					reverse := meetings[[2]uint{away, home}]
+					if tt.double && (direct != 1 || reverse != 1) {
+						t.Errorf("teams %d and %d met %d/%d times, want 1/1", home, away, direct, reverse)
+					}
+					if !tt.double && direct+reverse != 1 {
+						t.Errorf("teams %d and %d met %d times, want 1", home, away, direct+reverse)
+					}
+				}
+			}
+
+			minHome, maxHome := len(rounds), 0
+			for _, id := range teamIDs {
+				if homeGames[id] < minHome {
+					minHome = homeGames[id]
+				}
+				if homeGames[id] > maxHome {
+					maxHome = homeGames[id]
+				}
+			}
+			if maxHome-minHome > 1 {
+				t.Errorf("home games are unbalanced: min %d, max %d", minHome, maxHome)
+			}
+		})
+	}
+}
+
+// TestSpreadRoundDates tests that rounds are spread between the season dates
+func TestSpreadRoundDates(t *testing.T) {
+	startsAt := time.Date(2025, 1, 4, 15, 0, 0, 0, time.UTC)
+	endsAt := time.Date(2025, 5, 31, 15, 0, 0, 0, time.UTC)
+
+	dates := spreadRoundDates(startsAt, endsAt, 15)
+	if len(dates) != 15 {
+		t.Fatalf("spreadRoundDates() returned %d dates, want 15", len(dates))
+	}
+
+	if !dates[0].Equal(startsAt) {
+		t.Errorf("first round date = %v, want %v", dates[0], startsAt)
+	}
+
+	for i := 1; i < len(dates); i++ {
+		if !dates[i].After(dates[i-1]) {
+			t.Errorf("round %d date %v is not after round %d date %v", i+1, dates[i], i, dates[i-1])
+		}
+		if dates[i].After(endsAt) {
+			t.Errorf("round %d date %v is after the season end %v", i+1, dates[i], endsAt)
+		}
+		if dates[i].Hour() != startsAt.Hour() {
+			t.Errorf("round %d kick-off hour = %d, want %d", i+1, dates[i].Hour(), startsAt.Hour())
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"sort"
+)
+
+// MockGroupRepository is an in-memory implementation of GroupRepository for testing.
+// Teams are stored by ID only and take their names from teamName.
+type MockGroupRepository struct {
+	groups map[uint]*entities.Group
+	nextID uint
+}
+
+// NewMockGroupRepository creates a new mock group repository
+func NewMockGroupRepository() *MockGroupRepository {
+	return &MockGroupRepository{
+		groups: make(map[uint]*entities.Group),
+		nextID: 1,
+	}
+}
+
+func (m *MockGroupRepository) Create(group *entities.Group) error {
+	group.ID = m.nextID
+	m.nextID++
+	m.store(group)
+	return nil
+}
+
+func (m *MockGroupRepository) GetByID(id uint) (*entities.Group, error) {
+	if group, exists := m.groups[id]; exists {
+		found := *group
+		found.Teams = append([]entities.Team(nil), group.Teams...)
+		return &found, nil
+	}
+	return nil, errors.New("record not found")
+}
+
+func (m *MockGroupRepository) GetBySeasonID(seasonID uint) ([]entities.Group, error) {
+	groups := make([]entities.Group, 0)
+	for _, group := range m.groups {
+		if group.SeasonID == seasonID {
+			found, _ := m.GetByID(group.ID)
+			groups = append(groups, *found)
+		}
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+	return groups, nil
+}
+
+func (m *MockGroupRepository) Delete(id uint) error {
+	delete(m.groups, id)
+	return nil
+}
+
+func (m *MockGroupRepository) ReplaceTeams(groupID uint, teamIDs []uint) error {
+	group, exists := m.groups[groupID]
+	if !exists {
+		return errors.New("record not found")
+	}
+	group.Teams = nil
+	for _, teamID := range teamIDs {
+		group.Teams = append(group.Teams, entities.Team{ID: teamID, Name: teamName(teamID)})
+	}
+	return nil
+}
+
+func (m *MockGroupRepository) ReplaceForSeason(seasonID uint, groups []entities.Group) error {
+	for id, group := range m.groups {
+		if group.SeasonID == seasonID {
+			delete(m.groups, id)
+		}
+	}
+	for i := range groups {
+		if err := m.Create(&groups[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// store saves a copy of the group with named teams
+func (m *MockGroupRepository) store(group *entities.Group) {
+	stored := *group
+	stored.Teams = nil
+	for _, team := range group.Teams {
+		stored.Teams = append(stored.Teams, entities.Team{ID: team.ID, Name: teamName(team.ID)})
+	}
+	m.groups[group.ID] = &stored
+}
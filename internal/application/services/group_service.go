@@ -0,0 +1,343 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// maxDrawGroups is the number of groups that can be named with a single letter
+const maxDrawGroups = 26
+
+// GroupDrawOptions holds the parameters of a seeded group draw
+type GroupDrawOptions struct {
+	Groups int `json:"groups"`
+	// Seeds lists every enrolled team from the strongest to the weakest; it defaults to the
+	// enrolled teams in ID order. Each pot holds as many consecutive seeds as there are groups.
+	Seeds []uint `json:"seeds"`
+	// RandomSeed makes the draw reproducible; zero draws with the current time
+	RandomSeed int64 `json:"random_seed"`
+}
+
+// GroupService handles the groups of a group stage and the teams assigned to them
+type GroupService struct {
+	groupRepo          repositories.GroupRepository
+	seasonRepo         repositories.SeasonRepository
+	matchRepo          repositories.MatchRepository
+	leaderboardService *LeaderboardService
+}
+
+// NewGroupService creates a new group service instance
+func NewGroupService(groupRepo repositories.GroupRepository, seasonRepo repositories.SeasonRepository, matchRepo repositories.MatchRepository, leaderboardService *LeaderboardService) *GroupService {
+	return &GroupService{
+		groupRepo:          groupRepo,
+		seasonRepo:         seasonRepo,
+		matchRepo:          matchRepo,
+		leaderboardService: leaderboardService,
+	}
+}
+
+// CreateGroup creates a group in a season with the given teams
+func (s *GroupService) CreateGroup(seasonID uint, name string, teamIDs []uint) (*entities.Group, error) {
+	if seasonID == 0 {
+		return nil, errors.New("invalid season ID")
+	}
+
+	if name == "" {
+		return nil, errors.New("group name is required")
+	}
+
+	season, groups, err := s.loadForChange(seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range groups {
+		if group.Name == name {
+			return nil, fmt.Errorf("season already has a group named %s", name)
+		}
+	}
+
+	group := &entities.Group{SeasonID: seasonID, Name: name}
+	if err := validateGroupTeams(season, groups, group, teamIDs); err != nil {
+		return nil, err
+	}
+	for _, teamID := range teamIDs {
+		group.Teams = append(group.Teams, entities.Team{ID: teamID})
+	}
+
+	if err := s.groupRepo.Create(group); err != nil {
+		return nil, err
+	}
+
+	return s.groupRepo.GetByID(group.ID)
+}
+
+// GetGroupByID retrieves a group with its teams
+func (s *GroupService) GetGroupByID(id uint) (*entities.Group, error) {
+	if id == 0 {
+		return nil, errors.New("invalid group ID")
+	}
+
+	return s.groupRepo.GetByID(id)
+}
+
+// GetGroups retrieves the groups of a season ordered by name
+func (s *GroupService) GetGroups(seasonID uint) ([]entities.Group, error) {
+	if seasonID == 0 {
+		return nil, errors.New("invalid season ID")
+	}
+
+	return s.groupRepo.GetBySeasonID(seasonID)
+}
+
+// SetGroupTeams replaces the teams assigned to a group
+func (s *GroupService) SetGroupTeams(groupID uint, teamIDs []uint) (*entities.Group, error) {
+	group, err := s.GetGroupByID(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	season, groups, err := s.loadForChange(group.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateGroupTeams(season, groups, group, teamIDs); err != nil {
+		return nil, err
+	}
+
+	if err := s.groupRepo.ReplaceTeams(groupID, teamIDs); err != nil {
+		return nil, err
+	}
+
+	return s.groupRepo.GetByID(groupID)
+}
+
+// DeleteGroup deletes a group and its team assignments
+func (s *GroupService) DeleteGroup(id uint) error {
+	group, err := s.GetGroupByID(id)
+	if err != nil {
+		return err
+	}
+
+	if _, _, err := s.loadForChange(group.SeasonID); err != nil {
+		return err
+	}
+
+	return s.groupRepo.Delete(id)
+}
+
+// DrawGroups replaces the groups of a season with a seeded draw. The seeds are split into
+// pots of one team per group and every pot is drawn at random into the groups, so that the
+// strongest teams end up in different groups.
+func (s *GroupService) DrawGroups(seasonID uint, opts GroupDrawOptions) ([]entities.Group, error) {
+	if seasonID == 0 {
+		return nil, errors.New("invalid season ID")
+	}
+
+	if opts.Groups < 1 || opts.Groups > maxDrawGroups {
+		return nil, fmt.Errorf("number of groups must be between 1 and %d", maxDrawGroups)
+	}
+
+	season, _, err := s.loadForChange(seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	seeds := opts.Seeds
+	if len(seeds) == 0 {
+		seeds = sortedTeamIDs(season.Teams)
+	}
+	if len(seeds) != len(season.Teams) {
+		return nil, fmt.Errorf("seeds must list all %d enrolled teams", len(season.Teams))
+	}
+	seen := make(map[uint]bool, len(seeds))
+	for _, teamID := range seeds {
+		if seen[teamID] {
+			return nil, fmt.Errorf("team %d is seeded twice", teamID)
+		}
+		if !seasonHasTeam(season, teamID) {
+			return nil, fmt.Errorf("team %d is not enrolled in the season", teamID)
+		}
+		seen[teamID] = true
+	}
+
+	if len(seeds) < 2*opts.Groups {
+		return nil, fmt.Errorf("%d groups need at least %d enrolled teams", opts.Groups, 2*opts.Groups)
+	}
+
+	randomSeed := opts.RandomSeed
+	if randomSeed == 0 {
+		randomSeed = time.Now().UnixNano()
+	}
+	rng := rand.New(rand.NewSource(randomSeed))
+
+	groups := make([]entities.Group, opts.Groups)
+	for i := range groups {
+		groups[i] = entities.Group{SeasonID: seasonID, Name: "Group " + string(rune('A'+i))}
+	}
+
+	for start := 0; start < len(seeds); start += opts.Groups {
+		end := start + opts.Groups
+		if end > len(seeds) {
+			end = len(seeds)
+		}
+		pot := append([]uint(nil), seeds[start:end]...)
+		rng.Shuffle(len(pot), func(i, j int) { pot[i], pot[j] = pot[j], pot[i] })
+
+		// A partial last pot fills randomly chosen groups
+		slots := rng.Perm(opts.Groups)
+		for i, teamID := range pot {
+			group := &groups[slots[i]]
+			group.Teams = append(group.Teams, entities.Team{ID: teamID})
+		}
+	}
+
+	if err := s.groupRepo.ReplaceForSeason(seasonID, groups); err != nil {
+		return nil, err
+	}
+
+	return s.groupRepo.GetBySeasonID(seasonID)
+}
+
+// GetGroupStandings returns the leaderboard of a single group
+func (s *GroupService) GetGroupStandings(groupID uint) (*entities.GroupStandings, error) {
+	group, err := s.GetGroupByID(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.standings(group)
+}
+
+// GetSeasonGroupStandings returns the leaderboards of every group of a season
+func (s *GroupService) GetSeasonGroupStandings(seasonID uint) ([]entities.GroupStandings, error) {
+	groups, err := s.GetGroups(seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	standings := make([]entities.GroupStandings, 0, len(groups))
+	for i := range groups {
+		table, err := s.standings(&groups[i])
+		if err != nil {
+			return nil, err
+		}
+		standings = append(standings, *table)
+	}
+	return standings, nil
+}
+
+// Qualifiers returns the top teams of every group once the group stage is over, ordered as
+// knockout seeds: all group winners in group order, then all runners-up, and so on
+func (s *GroupService) Qualifiers(seasonID uint, perGroup int) ([]uint, error) {
+	if perGroup < 1 {
+		return nil, errors.New("at least one team per group must qualify")
+	}
+
+	matches, err := s.matchRepo.GetByStage(seasonID, entities.MatchStageRegular)
+	if err != nil {
+		return nil, err
+	}
+	for _, match := range matches {
+		status := entities.MatchStatus(match.Status)
+		if status != entities.MatchStatusFinished && status != entities.MatchStatusCancelled {
+			return nil, errors.New("group stage has unfinished matches")
+		}
+	}
+
+	standings, err := s.GetSeasonGroupStandings(seasonID)
+	if err != nil {
+		return nil, err
+	}
+	if len(standings) == 0 {
+		return nil, errors.New("season has no groups")
+	}
+
+	qualifiers := make([]uint, 0, perGroup*len(standings))
+	for position := 0; position < perGroup; position++ {
+		for _, group := range standings {
+			if len(group.Leaderboard) < perGroup {
+				return nil, fmt.Errorf("%s has %d teams, %d must qualify", group.Name, len(group.Leaderboard), perGroup)
+			}
+			qualifiers = append(qualifiers, group.Leaderboard[position].TeamID)
+		}
+	}
+	return qualifiers, nil
+}
+
+// standings builds the leaderboard of a group
+func (s *GroupService) standings(group *entities.Group) (*entities.GroupStandings, error) {
+	leaderboard, err := s.leaderboardService.GenerateGroupLeaderboard(group)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.GroupStandings{
+		GroupID:     group.ID,
+		Name:        group.Name,
+		Leaderboard: leaderboard,
+	}, nil
+}
+
+// loadForChange loads a season with its teams and groups, refusing changes to the groups
+// once group stage matches have been scheduled
+func (s *GroupService) loadForChange(seasonID uint) (*entities.Season, []entities.Group, error) {
+	season, err := s.seasonRepo.GetWithTeams(seasonID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	matches, err := s.matchRepo.GetByStage(seasonID, entities.MatchStageRegular)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(matches) > 0 {
+		return nil, nil, errors.New("groups cannot change once group stage matches are scheduled")
+	}
+
+	groups, err := s.groupRepo.GetBySeasonID(seasonID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return season, groups, nil
+}
+
+// validateGroupTeams checks that the teams are enrolled in the season and that none of them
+// is listed twice or already plays in another group of the season
+func validateGroupTeams(season *entities.Season, groups []entities.Group, group *entities.Group, teamIDs []uint) error {
+	seen := make(map[uint]bool, len(teamIDs))
+	for _, teamID := range teamIDs {
+		if seen[teamID] {
+			return fmt.Errorf("team %d is listed twice", teamID)
+		}
+		seen[teamID] = true
+
+		if !seasonHasTeam(season, teamID) {
+			return fmt.Errorf("team %d is not enrolled in the season", teamID)
+		}
+
+		for _, other := range groups {
+			if other.ID != group.ID && other.HasTeam(teamID) {
+				return fmt.Errorf("team %d already plays in %s", teamID, other.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// sortedTeamIDs returns the IDs of the teams in ascending order
+func sortedTeamIDs(teams []entities.Team) []uint {
+	ids := make([]uint, 0, len(teams))
+	for _, team := range teams {
+		ids = append(ids, team.ID)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
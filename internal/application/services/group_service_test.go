@@ -0,0 +1,151 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newTestGroupService creates group and playoff services for season 1 with the given teams enrolled
+func newTestGroupService(teamIDs ...uint) (*GroupService, *PlayoffService, *MockMatchRepository) {
+	ruleSetService, _, seasonRepo := newTestRuleSetService()
+	enrollTeams(seasonRepo, 1, teamIDs...)
+	matchRepo := NewMockMatchRepository()
+	leaderboardService := NewLeaderboardService(matchRepo, NewMockMatchPlayerRepository(matchRepo), ruleSetService, NewMockTeamSanctionRepository())
+	groupService := NewGroupService(NewMockGroupRepository(), seasonRepo, matchRepo, leaderboardService)
+	tieService := NewTieService(NewMockTieRepository(matchRepo), matchRepo)
+	return groupService, NewPlayoffService(matchRepo, leaderboardService, groupService, tieService), matchRepo
+}
+
+// groupTeamIDs returns the team IDs of every group in group order
+func groupTeamIDs(groups []entities.Group) [][]uint {
+	ids := make([][]uint, 0, len(groups))
+	for _, group := range groups {
+		ids = append(ids, sortedTeamIDs(group.Teams))
+	}
+	return ids
+}
+
+// TestGroupService_DrawGroups tests that a seeded draw keeps the teams of a pot apart and
+// is reproducible with the same random seed
+func TestGroupService_DrawGroups(t *testing.T) {
+	service, _, _ := newTestGroupService(1, 2, 3, 4, 5, 6, 7, 8)
+	opts := GroupDrawOptions{Groups: 2, Seeds: []uint{8, 7, 6, 5, 4, 3, 2, 1}, RandomSeed: 42}
+
+	groups, err := service.DrawGroups(1, opts)
+	if err != nil {
+		t.Fatalf("DrawGroups() error = %v", err)
+	}
+	if len(groups) != 2 || groups[0].Name != "Group A" || groups[1].Name != "Group B" {
+		t.Fatalf("got groups %+v, want Group A and Group B", groups)
+	}
+	for _, group := range groups {
+		if len(group.Teams) != 4 {
+			t.Errorf("%s has %d teams, want 4", group.Name, len(group.Teams))
+		}
+	}
+	for _, pot := range [][2]uint{{8, 7}, {6, 5}, {4, 3}, {2, 1}} {
+		if groups[0].HasTeam(pot[0]) == groups[0].HasTeam(pot[1]) {
+			t.Errorf("teams %d and %d of the same pot were drawn into the same group", pot[0], pot[1])
+		}
+	}
+
+	again, err := service.DrawGroups(1, opts)
+	if err != nil {
+		t.Fatalf("DrawGroups() error = %v", err)
+	}
+	if got, want := groupTeamIDs(again), groupTeamIDs(groups); !reflect.DeepEqual(got, want) {
+		t.Errorf("redraw with the same seed = %v, want %v", got, want)
+	}
+	if all, _ := service.GetGroups(1); len(all) != 2 {
+		t.Errorf("got %d groups after the redraw, want 2", len(all))
+	}
+
+	for _, bad := range []GroupDrawOptions{
+		{Groups: 0},
+		{Groups: 5},
+		{Groups: 2, Seeds: []uint{1, 2, 3}},
+		{Groups: 2, Seeds: []uint{1, 1, 2, 3, 4, 5, 6, 7}},
+		{Groups: 2, Seeds: []uint{1, 2, 3, 4, 5, 6, 7, 9}},
+	} {
+		if _, err := service.DrawGroups(1, bad); err == nil {
+			t.Errorf("DrawGroups(%+v) should fail", bad)
+		}
+	}
+}
+
+// TestGroupService_QualifyForKnockouts tests group leaderboards and the seeding of the top
+// two teams of every group into the semi-finals
+func TestGroupService_QualifyForKnockouts(t *testing.T) {
+	service, playoffService, matchRepo := newTestGroupService(1, 2, 3, 4, 5, 6, 7, 8, 9)
+
+	if _, err := service.CreateGroup(1, "Group A", []uint{1, 2, 3, 4}); err != nil {
+		t.Fatalf("CreateGroup() error = %v", err)
+	}
+	groupB, err := service.CreateGroup(1, "Group B", []uint{5, 6, 7})
+	if err != nil {
+		t.Fatalf("CreateGroup() error = %v", err)
+	}
+	if _, err := service.CreateGroup(1, "Group C", []uint{4}); err == nil {
+		t.Error("CreateGroup() should reject a team that already plays in another group")
+	}
+	if _, err := service.CreateGroup(1, "Group C", []uint{10}); err == nil {
+		t.Error("CreateGroup() should reject a team that is not enrolled")
+	}
+	if _, err := service.SetGroupTeams(groupB.ID, []uint{5, 6, 7, 8}); err != nil {
+		t.Fatalf("SetGroupTeams() error = %v", err)
+	}
+
+	// Lower team IDs beat higher ones inside each group; the match between groups is ignored
+	for _, group := range [][]uint{{1, 2, 3, 4}, {5, 6, 7, 8}} {
+		for i, home := range group {
+			for _, away := range group[i+1:] {
+				matchRepo.Create(newFinishedMatch(1, home, away, 1, 0))
+			}
+		}
+	}
+	matchRepo.Create(newFinishedMatch(1, 8, 1, 5, 0))
+	pending := newFinishedMatch(1, 5, 6, 0, 0)
+	pending.Status = string(entities.MatchStatusScheduled)
+	pending.HomeTeamScore, pending.AwayTeamScore = nil, nil
+	matchRepo.Create(pending)
+
+	if _, err := service.SetGroupTeams(groupB.ID, []uint{5, 6}); err == nil {
+		t.Error("SetGroupTeams() should fail once group matches are scheduled")
+	}
+
+	standings, err := service.GetSeasonGroupStandings(1)
+	if err != nil {
+		t.Fatalf("GetSeasonGroupStandings() error = %v", err)
+	}
+	if len(standings) != 2 {
+		t.Fatalf("got %d group tables, want 2", len(standings))
+	}
+	assertOrder(t, standings[0].Leaderboard, 1, 2, 3, 4)
+	assertOrder(t, standings[1].Leaderboard, 5, 6, 7, 8)
+	if entry := standings[1].Leaderboard[3]; entry.Played != 3 || entry.GoalsFor != 0 {
+		t.Errorf("team 8 has played %d matches scoring %d, want 3 group matches without goals", entry.Played, entry.GoalsFor)
+	}
+
+	opts := PlayoffOptions{Teams: 4, StadiumID: 1, Date: time.Date(2025, 6, 1, 15, 0, 0, 0, time.UTC)}
+	if _, err := playoffService.CreatePlayoffs(1, opts); err == nil {
+		t.Fatal("CreatePlayoffs() should wait for the group stage to finish")
+	}
+
+	pending.Status = string(entities.MatchStatusCancelled)
+	matchRepo.Update(pending)
+	semis, err := playoffService.CreatePlayoffs(1, opts)
+	if err != nil {
+		t.Fatalf("CreatePlayoffs() error = %v", err)
+	}
+	want := [][2]uint{{1, 6}, {5, 2}}
+	if len(semis) != len(want) {
+		t.Fatalf("got %d semi-finals, want %d", len(semis), len(want))
+	}
+	for i, match := range semis {
+		if got := [2]uint{match.HomeTeamID, match.AwayTeamID}; match.Stage != entities.MatchStageSemis || got != want[i] {
+			t.Errorf("semi %d = %v in stage %q, want %v", i+1, got, match.Stage, want[i])
+		}
+	}
+}
@@ -3,97 +3,409 @@ package services
 import (
 	"catalyst-players/internal/domain/entities"
 	"catalyst-players/internal/domain/repositories"
+	"errors"
+	"fmt"
 	"sort"
+	"time"
 )
 
+// DefaultFormLength is the number of latest results shown in the form of a leaderboard entry
+const DefaultFormLength = 5
+
+// LeaderboardOptions selects the matches a leaderboard is calculated from. A zero AsOf counts
+// every match, and a zero FormLength shows the default number of latest results.
+type LeaderboardOptions struct {
+	AsOf       time.Time
+	Venue      entities.Venue
+	FormLength int
+}
+
 // LeaderboardService provides services for generating leaderboards.
 type LeaderboardService struct {
-	matchRepo repositories.MatchRepository
+	matchRepo       repositories.MatchRepository
+	matchPlayerRepo repositories.MatchPlayerRepository
+	ruleSetService  *RuleSetService
+	sanctionRepo    repositories.TeamSanctionRepository
 }
 
 // NewLeaderboardService creates a new LeaderboardService.
-func NewLeaderboardService(matchRepo repositories.MatchRepository) *LeaderboardService {
-	return &LeaderboardService{matchRepo: matchRepo}
+func NewLeaderboardService(matchRepo repositories.MatchRepository, matchPlayerRepo repositories.MatchPlayerRepository, ruleSetService *RuleSetService, sanctionRepo repositories.TeamSanctionRepository) *LeaderboardService {
+	return &LeaderboardService{
+		matchRepo:       matchRepo,
+		matchPlayerRepo: matchPlayerRepo,
+		ruleSetService:  ruleSetService,
+		sanctionRepo:    sanctionRepo,
+	}
 }
 
-// GenerateLeaderboard calculates and returns the leaderboard for a given season.
+// GenerateLeaderboard calculates and returns the leaderboard for a given season, less the
+// points deducted by the sanctions of the season.
 func (s *LeaderboardService) GenerateLeaderboard(seasonID uint) (entities.Leaderboard, error) {
-	// 1. Fetch all finished matches for the season
-	matches, err := s.matchRepo.GetCompleted(seasonID)
+	return s.generate(seasonID, nil, LeaderboardOptions{})
+}
+
+// GenerateLeaderboardAsOf calculates the leaderboard of a season as it stood at the given
+// time, counting only the matches played and the sanctions dated until then.
+func (s *LeaderboardService) GenerateLeaderboardAsOf(seasonID uint, asOf time.Time) (entities.Leaderboard, error) {
+	return s.generate(seasonID, nil, LeaderboardOptions{AsOf: asOf})
+}
+
+// GenerateLeaderboardWithOptions calculates the leaderboard of a season from the selected
+// matches. Home and away tables only count each team's matches at that venue and leave
+// out sanctions, which are not tied to a venue.
+func (s *LeaderboardService) GenerateLeaderboardWithOptions(seasonID uint, opts LeaderboardOptions) (entities.Leaderboard, error) {
+	switch opts.Venue {
+	case entities.VenueAll, entities.VenueHome, entities.VenueAway:
+	default:
+		return nil, fmt.Errorf("unknown venue %q", opts.Venue)
+	}
+
+	if opts.FormLength < 0 {
+		return nil, errors.New("form length cannot be negative")
+	}
+
+	return s.generate(seasonID, nil, opts)
+}
+
+// GenerateGroupLeaderboard calculates the leaderboard of a group from the regular stage
+// matches played between its teams. Every team of the group is listed, even before it plays.
+func (s *LeaderboardService) GenerateGroupLeaderboard(group *entities.Group) (entities.Leaderboard, error) {
+	teams := group.Teams
+	if teams == nil {
+		teams = []entities.Team{}
+	}
+	return s.generate(group.SeasonID, teams, LeaderboardOptions{})
+}
+
+// GenerateHistory calculates the leaderboard of a season after every round in a single pass
+// over its completed matches. When a counted match has no round, the table is taken after
+// every match day instead. Every team that has played is listed from the first snapshot.
+func (s *LeaderboardService) GenerateHistory(seasonID uint) (entities.StandingsHistory, error) {
+	rules, matches, sanctions, err := s.load(seasonID)
 	if err != nil {
 		return nil, err
 	}
 
-	// 2. Process matches to calculate standings
-	standings := make(map[uint]*entities.LeaderboardEntry)
-
+	table := newStandingsTable(rules, nil, LeaderboardOptions{})
+	counted := make([]entities.Match, 0, len(matches))
+	byRound := true
 	for _, match := range matches {
-		// Ensure teams are loaded
-		if match.HomeTeam.ID == 0 || match.AwayTeam.ID == 0 {
-			continue // Or handle error, for now skip if team data is missing
+		if !table.counts(match) || match.HomeTeamScore == nil || match.AwayTeamScore == nil {
+			continue
 		}
+		counted = append(counted, match)
+		byRound = byRound && match.Round > 0
+		table.seed(match.HomeTeam)
+		table.seed(match.AwayTeam)
+	}
 
-		// Get or create entries for home and away teams
-		if _, ok := standings[match.HomeTeamID]; !ok {
-			standings[match.HomeTeamID] = &entities.LeaderboardEntry{TeamID: match.HomeTeamID, TeamName: match.HomeTeam.Name}
+	stats, err := s.cardStats(seasonID, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	// Matches of a round are played in date order; rounds follow each other by number
+	sortMatchesChronologically(counted)
+	if byRound {
+		sort.SliceStable(counted, func(i, j int) bool { return counted[i].Round < counted[j].Round })
+	}
+
+	history := make(entities.StandingsHistory, 0)
+	for i, match := range counted {
+		table.add(match)
+
+		if i+1 < len(counted) && sameStandingsStep(match, counted[i+1], byRound) {
+			continue
 		}
-		if _, ok := standings[match.AwayTeamID]; !ok {
-			standings[match.AwayTeamID] = &entities.LeaderboardEntry{TeamID: match.AwayTeamID, TeamName: match.AwayTeam.Name}
+
+		snapshot := entities.StandingsSnapshot{Date: match.Date}
+		if byRound {
+			snapshot.Round = match.Round
 		}
+		snapshot.Leaderboard = table.leaderboard(sanctionsUntil(sanctions, snapshot.Date), stats)
+		history = append(history, snapshot)
+	}
+
+	return history, nil
+}
+
+// generate calculates the leaderboard of a season. When teams is not nil, only the matches
+// played between those teams count and every one of them gets an entry.
+func (s *LeaderboardService) generate(seasonID uint, teams []entities.Team, opts LeaderboardOptions) (entities.Leaderboard, error) {
+	// 1. Resolve the points and tiebreaker rules and fetch all finished matches for the season
+	rules, matches, sanctions, err := s.load(seasonID)
+	if err != nil {
+		return nil, err
+	}
 
-		homeEntry := standings[match.HomeTeamID]
-		awayEntry := standings[match.AwayTeamID]
-
-		// Update stats based on score
-		if match.HomeTeamScore != nil && match.AwayTeamScore != nil {
-			homeScore := *match.HomeTeamScore
-			awayScore := *match.AwayTeamScore
-
-			homeEntry.Played++
-			awayEntry.Played++
-
-			homeEntry.GoalsFor += homeScore
-			homeEntry.GoalsAgainst += awayScore
-			awayEntry.GoalsFor += awayScore
-			awayEntry.GoalsAgainst += homeScore
-
-			if homeScore > awayScore { // Home team wins
-				homeEntry.Won++
-				homeEntry.Points += 3
-				awayEntry.Lost++
-			} else if awayScore > homeScore { // Away team wins
-				awayEntry.Won++
-				awayEntry.Points += 3
-				homeEntry.Lost++
-			} else { // Draw
-				homeEntry.Drawn++
-				homeEntry.Points++
-				awayEntry.Drawn++
-				awayEntry.Points++
-			}
+	// 2. Process matches in the order they were played to calculate standings and form
+	sortMatchesChronologically(matches)
+	table := newStandingsTable(rules, teams, opts)
+	for _, match := range matches {
+		if !opts.AsOf.IsZero() && match.Date.After(opts.AsOf) {
+			continue
 		}
+		table.add(match)
+	}
+
+	// 3. Deduct the points of the season sanctions and sort the leaderboard by points,
+	// then by the season tiebreakers
+	stats, err := s.cardStats(seasonID, rules)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case opts.Venue != entities.VenueAll:
+		sanctions = nil
+	case !opts.AsOf.IsZero():
+		sanctions = sanctionsUntil(sanctions, opts.AsOf)
+	}
+
+	return table.leaderboard(sanctions, stats), nil
+}
+
+// project loads the finished matches, sanctions and card statistics of a season once, so
+// that its final leaderboard can be taken for any results of the matches still to be played
+func (s *LeaderboardService) project(seasonID uint) (*standingsProjection, error) {
+	rules, matches, sanctions, err := s.load(seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.cardStats(seasonID, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	sortMatchesChronologically(matches)
+	return &standingsProjection{rules: rules, matches: matches, sanctions: sanctions, stats: stats}, nil
+}
+
+// load resolves the rules of a season and fetches its finished matches and its sanctions
+func (s *LeaderboardService) load(seasonID uint) (*entities.RuleSet, []entities.Match, []entities.TeamSanction, error) {
+	rules, err := s.ruleSetService.ResolveForSeason(seasonID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	matches, err := s.matchRepo.GetCompleted(seasonID)
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
-	// 3. Convert map to slice and calculate goal difference
-	leaderboard := make(entities.Leaderboard, 0, len(standings))
-	for _, entry := range standings {
-		entry.GoalDifference = entry.GoalsFor - entry.GoalsAgainst
-		leaderboard = append(leaderboard, *entry)
+	sanctions, err := s.sanctionRepo.GetBySeasonID(seasonID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return rules, matches, sanctions, nil
+}
+
+// cardStats fetches the player statistics of a season when the rules break ties on fair play
+func (s *LeaderboardService) cardStats(seasonID uint, rules *entities.RuleSet) ([]entities.MatchPlayer, error) {
+	if !newStandingsRanker(rules, nil, entities.VenueAll).needs(entities.TiebreakerFairPlay) {
+		return nil, nil
+	}
+	return s.matchPlayerRepo.GetBySeasonID(seasonID)
+}
+
+// standingsProjection holds everything the leaderboard of a season is calculated from. It
+// is only read once loaded, so leaderboards can be taken from several goroutines at once.
+type standingsProjection struct {
+	rules     *entities.RuleSet
+	matches   []entities.Match
+	sanctions []entities.TeamSanction
+	stats     []entities.MatchPlayer
+}
+
+// counts reports whether a match belongs in the league table of the season
+func (p *standingsProjection) counts(match entities.Match) bool {
+	return newStandingsTable(p.rules, nil, LeaderboardOptions{}).counts(match)
+}
+
+// leaderboard returns the leaderboard of the season once the given matches are finished
+// as well, less the points deducted by every sanction of the season
+func (p *standingsProjection) leaderboard(finished []entities.Match) entities.Leaderboard {
+	table := newStandingsTable(p.rules, nil, LeaderboardOptions{})
+	for _, match := range p.matches {
+		table.add(match)
+	}
+	for _, match := range finished {
+		table.add(match)
+	}
+	return table.leaderboard(p.sanctions, p.stats)
+}
+
+// standingsTable accumulates the standings of a season one match at a time, so that the
+// leaderboard can be taken at any point of the season
+type standingsTable struct {
+	rules      *entities.RuleSet
+	restricted bool
+	venue      entities.Venue
+	formLength int
+	standings  map[uint]*entities.LeaderboardEntry
+	results    map[uint][]byte
+	played     []entities.Match
+}
+
+// newStandingsTable creates an empty table. When teams is not nil, only the matches played
+// between those teams count and every one of them gets an entry.
+func newStandingsTable(rules *entities.RuleSet, teams []entities.Team, opts LeaderboardOptions) *standingsTable {
+	formLength := opts.FormLength
+	if formLength == 0 {
+		formLength = DefaultFormLength
+	}
+
+	table := &standingsTable{
+		rules:      rules,
+		restricted: teams != nil,
+		venue:      opts.Venue,
+		formLength: formLength,
+		standings:  make(map[uint]*entities.LeaderboardEntry),
+		results:    make(map[uint][]byte),
+	}
+	for _, team := range teams {
+		table.seed(team)
+	}
+	return table
+}
+
+// seed lists a team in the table before it has played
+func (t *standingsTable) seed(team entities.Team) {
+	if _, ok := t.standings[team.ID]; !ok {
+		t.standings[team.ID] = &entities.LeaderboardEntry{TeamID: team.ID, TeamName: team.Name}
+	}
+}
+
+// counts reports whether a match belongs in the table
+func (t *standingsTable) counts(match entities.Match) bool {
+	// Knockout matches do not count towards the league table
+	if match.Stage != "" && match.Stage != entities.MatchStageRegular {
+		return false
+	}
+
+	// Group tables only count the matches played inside the group
+	if t.restricted && (t.standings[match.HomeTeamID] == nil || t.standings[match.AwayTeamID] == nil) {
+		return false
+	}
+
+	// Ensure teams are loaded
+	return match.HomeTeam.ID != 0 && match.AwayTeam.ID != 0
+}
+
+// add counts the result of a finished match in the table
+func (t *standingsTable) add(match entities.Match) {
+	if !t.counts(match) {
+		return
 	}
 
-	// 4. Sort the leaderboard
-	sort.Slice(leaderboard, func(i, j int) bool {
-		if leaderboard[i].Points != leaderboard[j].Points {
-			return leaderboard[i].Points > leaderboard[j].Points // More points is better
+	// Get or create entries for home and away teams
+	t.seed(match.HomeTeam)
+	t.seed(match.AwayTeam)
+	homeEntry := t.standings[match.HomeTeamID]
+	awayEntry := t.standings[match.AwayTeamID]
+
+	// Update stats based on score; venue tables only count the side of the selected venue
+	if match.HomeTeamScore != nil && match.AwayTeamScore != nil {
+		homeScore := *match.HomeTeamScore
+		awayScore := *match.AwayTeamScore
+		t.played = append(t.played, match)
+
+		if t.venue != entities.VenueAway {
+			t.record(homeEntry, homeScore, awayScore)
 		}
-		if leaderboard[i].GoalDifference != leaderboard[j].GoalDifference {
-			return leaderboard[i].GoalDifference > leaderboard[j].GoalDifference // Higher GD is better
+		if t.venue != entities.VenueHome {
+			t.record(awayEntry, awayScore, homeScore)
 		}
-		if leaderboard[i].GoalsFor != leaderboard[j].GoalsFor {
-			return leaderboard[i].GoalsFor > leaderboard[j].GoalsFor // More goals scored is better
+	}
+}
+
+// record counts the result of a match for one of the teams that played it
+func (t *standingsTable) record(entry *entities.LeaderboardEntry, goalsFor, goalsAgainst int) {
+	entry.Played++
+	entry.GoalsFor += goalsFor
+	entry.GoalsAgainst += goalsAgainst
+	entry.Points += t.rules.Points(goalsFor, goalsAgainst)
+
+	result := byte('D')
+	if goalsFor > goalsAgainst {
+		entry.Won++
+		result = 'W'
+	} else if goalsFor < goalsAgainst {
+		entry.Lost++
+		result = 'L'
+	} else {
+		entry.Drawn++
+	}
+	t.results[entry.TeamID] = append(t.results[entry.TeamID], result)
+}
+
+// leaderboard returns the ranked standings of the matches added so far, less the points
+// deducted by the given sanctions. The table itself is left untouched.
+func (t *standingsTable) leaderboard(sanctions []entities.TeamSanction, stats []entities.MatchPlayer) entities.Leaderboard {
+	deducted := make(map[uint]int)
+	for _, sanction := range sanctions {
+		deducted[sanction.TeamID] += sanction.PointsDeducted
+	}
+
+	leaderboard := make(entities.Leaderboard, 0, len(t.standings))
+	for _, entry := range t.standings {
+		row := *entry
+		row.GoalDifference = row.GoalsFor - row.GoalsAgainst
+		row.PointsDeducted = deducted[row.TeamID]
+		row.Points -= row.PointsDeducted
+		row.Form, row.WinningStreak, row.UnbeatenStreak, row.LosingStreak = form(t.results[row.TeamID], t.formLength)
+		leaderboard = append(leaderboard, row)
+	}
+
+	ranker := newStandingsRanker(t.rules, t.played, t.venue)
+	if ranker.needs(entities.TiebreakerFairPlay) {
+		ranker.addCards(stats)
+	}
+	ranker.rank(leaderboard)
+
+	return leaderboard
+}
+
+// form returns the latest results of a team, oldest first, and its current winning,
+// unbeaten and losing streaks
+func form(results []byte, length int) (latest string, winning, unbeaten, losing int) {
+	if len(results) > length {
+		latest = string(results[len(results)-length:])
+	} else {
+		latest = string(results)
+	}
+
+	for i := len(results) - 1; i >= 0 && results[i] == 'W'; i-- {
+		winning++
+	}
+	for i := len(results) - 1; i >= 0 && results[i] != 'L'; i-- {
+		unbeaten++
+	}
+	for i := len(results) - 1; i >= 0 && results[i] == 'L'; i-- {
+		losing++
+	}
+	return latest, winning, unbeaten, losing
+}
+
+// sanctionsUntil returns the sanctions dated at or before the given time
+func sanctionsUntil(sanctions []entities.TeamSanction, until time.Time) []entities.TeamSanction {
+	dated := make([]entities.TeamSanction, 0, len(sanctions))
+	for _, sanction := range sanctions {
+		if !sanction.Date.After(until) {
+			dated = append(dated, sanction)
 		}
-		return leaderboard[i].TeamName < leaderboard[j].TeamName // Alphabetical as a tie-breaker
-	})
+	}
+	return dated
+}
 
-	return leaderboard, nil
+// sameStandingsStep reports whether two consecutive matches fall in the same round, or on
+// the same day when the history is not taken by round
+func sameStandingsStep(a, b entities.Match, byRound bool) bool {
+	if byRound {
+		return a.Round == b.Round
+	}
+	ay, am, ad := a.Date.Date()
+	by, bm, bd := b.Date.Date()
+	return ay == by && am == bm && ad == bd
 }
@@ -0,0 +1,379 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"testing"
+	"time"
+)
+
+// leaderboardOrder returns the team IDs of a leaderboard from first to last
+func leaderboardOrder(leaderboard entities.Leaderboard) []uint {
+	order := make([]uint, 0, len(leaderboard))
+	for _, entry := range leaderboard {
+		order = append(order, entry.TeamID)
+	}
+	return order
+}
+
+// assertOrder fails the test when the leaderboard is not in the expected order
+func assertOrder(t *testing.T, leaderboard entities.Leaderboard, want ...uint) {
+	t.Helper()
+	got := leaderboardOrder(leaderboard)
+	if len(got) != len(want) {
+		t.Fatalf("leaderboard order = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("leaderboard order = %v, want %v", got, want)
+		}
+	}
+}
+
+// TestLeaderboardService_DefaultRules tests the 3/1/0 points and goal difference ordering
+func TestLeaderboardService_DefaultRules(t *testing.T) {
+	repo := NewMockMatchRepository()
+	ruleSetService, _, _ := newTestRuleSetService()
+	service := NewLeaderboardService(repo, NewMockMatchPlayerRepository(repo), ruleSetService, NewMockTeamSanctionRepository())
+
+	repo.Create(newFinishedMatch(1, 1, 2, 1, 0))
+	repo.Create(newFinishedMatch(1, 2, 3, 4, 0))
+	repo.Create(newFinishedMatch(1, 3, 1, 1, 0))
+
+	leaderboard, err := service.GenerateLeaderboard(1)
+	if err != nil {
+		t.Fatalf("GenerateLeaderboard() error = %v", err)
+	}
+
+	// All teams have 3 points; team 2 has the best goal difference
+	assertOrder(t, leaderboard, 2, 1, 3)
+	if leaderboard[0].Points != 3 || leaderboard[0].GoalDifference != 3 {
+		t.Errorf("leader has %d points and %d goal difference, want 3 and 3", leaderboard[0].Points, leaderboard[0].GoalDifference)
+	}
+}
+
+// TestLeaderboardService_CustomRules tests custom points and tiebreaker order from the rule set
+func TestLeaderboardService_CustomRules(t *testing.T) {
+	repo := NewMockMatchRepository()
+	matchPlayerRepo := NewMockMatchPlayerRepository(repo)
+	ruleSetService, _, _ := newTestRuleSetService()
+	service := NewLeaderboardService(repo, matchPlayerRepo, ruleSetService, NewMockTeamSanctionRepository())
+
+	// Team 1 beat team 2, but team 2 has the better goal difference
+	repo.Create(newFinishedMatch(1, 1, 2, 1, 0))
+	repo.Create(newFinishedMatch(1, 2, 3, 5, 0))
+	repo.Create(newFinishedMatch(1, 1, 3, 0, 0))
+	repo.Create(newFinishedMatch(1, 3, 2, 0, 0))
+
+	youthRules := &entities.RuleSet{
+		PointsForWin:  2,
+		PointsForDraw: 1,
+		Tiebreakers:   []entities.Tiebreaker{entities.TiebreakerHeadToHead, entities.TiebreakerGoalDifference},
+	}
+	if err := ruleSetService.SetLeagueRules(1, youthRules); err != nil {
+		t.Fatalf("SetLeagueRules() error = %v", err)
+	}
+
+	leaderboard, err := service.GenerateLeaderboard(1)
+	if err != nil {
+		t.Fatalf("GenerateLeaderboard() error = %v", err)
+	}
+	assertOrder(t, leaderboard, 1, 2, 3)
+	if leaderboard[0].Points != 3 || leaderboard[1].Points != 3 {
+		t.Errorf("top two teams have %d and %d points, want 3 and 3", leaderboard[0].Points, leaderboard[1].Points)
+	}
+
+	// Fair play decides when the teams cannot be separated otherwise
+	repo2 := NewMockMatchRepository()
+	matchPlayerRepo2 := NewMockMatchPlayerRepository(repo2)
+	service2 := NewLeaderboardService(repo2, matchPlayerRepo2, ruleSetService, NewMockTeamSanctionRepository())
+	repo2.Create(newFinishedMatch(1, 1, 2, 1, 1))
+	matchPlayerRepo2.Create(&entities.MatchPlayer{MatchID: 1, TeamID: 1, PlayerID: 10, YellowCard: 2})
+	matchPlayerRepo2.Create(&entities.MatchPlayer{MatchID: 1, TeamID: 2, PlayerID: 20, RedCard: 1})
+
+	fairPlayRules := &entities.RuleSet{
+		PointsForWin:  3,
+		PointsForDraw: 1,
+		Tiebreakers:   []entities.Tiebreaker{entities.TiebreakerGoalDifference, entities.TiebreakerFairPlay},
+	}
+	if err := ruleSetService.SetSeasonRules(1, fairPlayRules); err != nil {
+		t.Fatalf("SetSeasonRules() error = %v", err)
+	}
+
+	leaderboard, err = service2.GenerateLeaderboard(1)
+	if err != nil {
+		t.Fatalf("GenerateLeaderboard() error = %v", err)
+	}
+	assertOrder(t, leaderboard, 1, 2)
+}
+
+// newHeadToHeadLeaderboardService creates a leaderboard service whose season ranks ties
+// on head-to-head first, then on goal difference and goals scored
+func newHeadToHeadLeaderboardService(t *testing.T) (*LeaderboardService, *MockMatchRepository) {
+	t.Helper()
+	repo := NewMockMatchRepository()
+	ruleSetService, _, _ := newTestRuleSetService()
+	rules := &entities.RuleSet{
+		PointsForWin:  3,
+		PointsForDraw: 1,
+		Tiebreakers: []entities.Tiebreaker{
+			entities.TiebreakerHeadToHead,
+			entities.TiebreakerGoalDifference,
+			entities.TiebreakerGoalsFor,
+		},
+	}
+	if err := ruleSetService.SetSeasonRules(1, rules); err != nil {
+		t.Fatalf("SetSeasonRules() error = %v", err)
+	}
+	return NewLeaderboardService(repo, NewMockMatchPlayerRepository(repo), ruleSetService, NewMockTeamSanctionRepository()), repo
+}
+
+// TestLeaderboardService_HeadToHeadThreeWayTie tests a three-way tie where the mini-table
+// separates one team and the other two are separated by reapplying it to their own match
+func TestLeaderboardService_HeadToHeadThreeWayTie(t *testing.T) {
+	service, repo := newHeadToHeadLeaderboardService(t)
+
+	// Mini-table: every team has 3 points and a goal difference of 0,
+	// team 1 scored 3 goals while teams 2 and 3 scored 2 each
+	repo.Create(newFinishedMatch(1, 1, 2, 2, 1))
+	repo.Create(newFinishedMatch(1, 3, 1, 2, 1))
+	repo.Create(newFinishedMatch(1, 2, 3, 1, 0))
+
+	// Everyone beats team 4, team 3 by the widest margin
+	repo.Create(newFinishedMatch(1, 1, 4, 1, 0))
+	repo.Create(newFinishedMatch(1, 2, 4, 1, 0))
+	repo.Create(newFinishedMatch(1, 3, 4, 5, 0))
+
+	leaderboard, err := service.GenerateLeaderboard(1)
+	if err != nil {
+		t.Fatalf("GenerateLeaderboard() error = %v", err)
+	}
+
+	// Team 2 finishes above team 3 because it won their match, despite the worse goal difference
+	assertOrder(t, leaderboard, 1, 2, 3, 4)
+	for _, entry := range leaderboard[:3] {
+		if entry.Points != 6 {
+			t.Errorf("team %d has %d points, want 6", entry.TeamID, entry.Points)
+		}
+	}
+}
+
+// TestLeaderboardService_HeadToHeadCircularTie tests a three-way tie the mini-table cannot
+// separate, which falls through to the overall goal difference
+func TestLeaderboardService_HeadToHeadCircularTie(t *testing.T) {
+	service, repo := newHeadToHeadLeaderboardService(t)
+
+	repo.Create(newFinishedMatch(1, 1, 2, 1, 0))
+	repo.Create(newFinishedMatch(1, 2, 3, 1, 0))
+	repo.Create(newFinishedMatch(1, 3, 1, 1, 0))
+
+	repo.Create(newFinishedMatch(1, 1, 4, 1, 0))
+	repo.Create(newFinishedMatch(1, 2, 4, 3, 0))
+	repo.Create(newFinishedMatch(1, 3, 4, 2, 0))
+
+	leaderboard, err := service.GenerateLeaderboard(1)
+	if err != nil {
+		t.Fatalf("GenerateLeaderboard() error = %v", err)
+	}
+	assertOrder(t, leaderboard, 2, 3, 1, 4)
+}
+
+// TestLeaderboardService_HeadToHeadPointsSeparateAll tests a three-way tie fully resolved
+// by head-to-head points while the overall goal difference points the other way
+func TestLeaderboardService_HeadToHeadPointsSeparateAll(t *testing.T) {
+	service, repo := newHeadToHeadLeaderboardService(t)
+
+	// Mini-table: team 3 has 4 points, team 1 has 3, team 2 has 1
+	repo.Create(newFinishedMatch(1, 1, 2, 1, 0))
+	repo.Create(newFinishedMatch(1, 2, 3, 1, 1))
+	repo.Create(newFinishedMatch(1, 3, 1, 1, 0))
+
+	// Results against teams 4 and 5 bring all three teams to 7 points
+	repo.Create(newFinishedMatch(1, 1, 4, 1, 0))
+	repo.Create(newFinishedMatch(1, 1, 5, 1, 1))
+	repo.Create(newFinishedMatch(1, 2, 4, 6, 0))
+	repo.Create(newFinishedMatch(1, 2, 5, 6, 0))
+	repo.Create(newFinishedMatch(1, 3, 4, 1, 0))
+	repo.Create(newFinishedMatch(1, 3, 5, 0, 1))
+
+	leaderboard, err := service.GenerateLeaderboard(1)
+	if err != nil {
+		t.Fatalf("GenerateLeaderboard() error = %v", err)
+	}
+	assertOrder(t, leaderboard[:3], 3, 1, 2)
+}
+
+// newRoundMatch creates a finished regular match of season 1 in the given round and day of March
+func newRoundMatch(round, day int, homeID, awayID uint, homeScore, awayScore int) *entities.Match {
+	match := newFinishedMatch(1, homeID, awayID, homeScore, awayScore)
+	match.Round = round
+	match.Date = time.Date(2025, 3, day, 15, 0, 0, 0, time.UTC)
+	return match
+}
+
+// TestLeaderboardService_GenerateHistory tests that the history has the table after every
+// round, applying sanctions from their date, and that a past table matches its snapshot
+func TestLeaderboardService_GenerateHistory(t *testing.T) {
+	repo := NewMockMatchRepository()
+	ruleSetService, _, _ := newTestRuleSetService()
+	sanctionRepo := NewMockTeamSanctionRepository()
+	service := NewLeaderboardService(repo, NewMockMatchPlayerRepository(repo), ruleSetService, sanctionRepo)
+
+	// The match of round 3 was created first; rounds still come in order
+	repo.Create(newRoundMatch(3, 15, 3, 1, 3, 0))
+	repo.Create(newRoundMatch(1, 1, 1, 2, 2, 0))
+	repo.Create(newRoundMatch(2, 8, 2, 3, 1, 0))
+	sanctionRepo.Create(&entities.TeamSanction{SeasonID: 1, TeamID: 3, PointsDeducted: 1, Reason: "Unpaid fees",
+		Date: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)})
+
+	history, err := service.GenerateHistory(1)
+	if err != nil {
+		t.Fatalf("GenerateHistory() error = %v", err)
+	}
+	if len(history) != 3 {
+		t.Fatalf("got %d snapshots, want one per round", len(history))
+	}
+	for i, snapshot := range history {
+		if snapshot.Round != i+1 {
+			t.Errorf("snapshot %d is for round %d", i, snapshot.Round)
+		}
+	}
+	assertOrder(t, history[0].Leaderboard, 1, 3, 2)
+	assertOrder(t, history[1].Leaderboard, 1, 2, 3)
+	assertOrder(t, history[2].Leaderboard, 1, 2, 3)
+	if last := history[2].Leaderboard[2]; last.Points != 2 || last.PointsDeducted != 1 {
+		t.Errorf("team 3 after round 3 = %+v, want 2 points with 1 deducted", last)
+	}
+
+	past, err := service.GenerateLeaderboardAsOf(1, time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("GenerateLeaderboardAsOf() error = %v", err)
+	}
+	assertOrder(t, past, 1, 2, 3)
+	if past[2].Points != 0 || past[2].PointsDeducted != 0 || past[0].Played != 1 {
+		t.Errorf("table as of round 2 = %+v, want it without round 3 and the sanction", past)
+	}
+}
+
+// TestLeaderboardService_GenerateHistoryByMatchDay tests that matches without rounds are
+// grouped by the day they were played on
+func TestLeaderboardService_GenerateHistoryByMatchDay(t *testing.T) {
+	repo := NewMockMatchRepository()
+	ruleSetService, _, _ := newTestRuleSetService()
+	service := NewLeaderboardService(repo, NewMockMatchPlayerRepository(repo), ruleSetService, NewMockTeamSanctionRepository())
+
+	repo.Create(newRoundMatch(0, 1, 1, 2, 1, 0))
+	repo.Create(newRoundMatch(0, 1, 3, 4, 0, 0))
+	repo.Create(newRoundMatch(0, 8, 4, 1, 2, 0))
+
+	history, err := service.GenerateHistory(1)
+	if err != nil {
+		t.Fatalf("GenerateHistory() error = %v", err)
+	}
+	if len(history) != 2 {
+		t.Fatalf("got %d snapshots, want one per match day", len(history))
+	}
+	if len(history[0].Leaderboard) != 4 || history[0].Round != 0 || history[0].Date.Day() != 1 {
+		t.Errorf("first snapshot = %+v, want all four teams after March 1", history[0])
+	}
+	assertOrder(t, history[1].Leaderboard, 4, 1, 3, 2)
+}
+
+// TestLeaderboardService_FormAndStreaks tests that the form lists the latest results in the
+// order they were played and that the streaks run up to the latest match
+func TestLeaderboardService_FormAndStreaks(t *testing.T) {
+	repo := NewMockMatchRepository()
+	ruleSetService, _, _ := newTestRuleSetService()
+	service := NewLeaderboardService(repo, NewMockMatchPlayerRepository(repo), ruleSetService, NewMockTeamSanctionRepository())
+
+	// Created out of order: team 1 loses, wins, draws, wins, wins
+	repo.Create(newRoundMatch(0, 22, 1, 3, 2, 1))
+	repo.Create(newRoundMatch(0, 1, 1, 2, 0, 1))
+	repo.Create(newRoundMatch(0, 15, 3, 1, 1, 1))
+	repo.Create(newRoundMatch(0, 8, 2, 1, 0, 3))
+	repo.Create(newRoundMatch(0, 29, 2, 1, 1, 2))
+
+	leaderboard, err := service.GenerateLeaderboardWithOptions(1, LeaderboardOptions{FormLength: 4})
+	if err != nil {
+		t.Fatalf("GenerateLeaderboardWithOptions() error = %v", err)
+	}
+	assertOrder(t, leaderboard, 1, 2, 3)
+
+	tests := []struct {
+		entry                       entities.LeaderboardEntry
+		form                        string
+		winning, unbeaten, defeated int
+	}{
+		{entry: leaderboard[0], form: "WDWW", winning: 2, unbeaten: 4},
+		{entry: leaderboard[1], form: "WLL", defeated: 2},
+		{entry: leaderboard[2], form: "DL", defeated: 1},
+	}
+	for _, tt := range tests {
+		if tt.entry.Form != tt.form || tt.entry.WinningStreak != tt.winning ||
+			tt.entry.UnbeatenStreak != tt.unbeaten || tt.entry.LosingStreak != tt.defeated {
+			t.Errorf("team %d has form %q and streaks %d/%d/%d, want %q and %d/%d/%d", tt.entry.TeamID,
+				tt.entry.Form, tt.entry.WinningStreak, tt.entry.UnbeatenStreak, tt.entry.LosingStreak,
+				tt.form, tt.winning, tt.unbeaten, tt.defeated)
+		}
+	}
+}
+
+// TestLeaderboardService_VenueTables tests that home and away tables only count each team's
+// matches at that venue and leave sanctions out
+func TestLeaderboardService_VenueTables(t *testing.T) {
+	repo := NewMockMatchRepository()
+	ruleSetService, _, _ := newTestRuleSetService()
+	sanctionRepo := NewMockTeamSanctionRepository()
+	service := NewLeaderboardService(repo, NewMockMatchPlayerRepository(repo), ruleSetService, sanctionRepo)
+
+	repo.Create(newFinishedMatch(1, 1, 2, 3, 0))
+	repo.Create(newFinishedMatch(1, 2, 1, 2, 0))
+	repo.Create(newFinishedMatch(1, 2, 3, 1, 1))
+	sanctionRepo.Create(&entities.TeamSanction{SeasonID: 1, TeamID: 1, PointsDeducted: 3, Reason: "Unpaid fees"})
+
+	home, err := service.GenerateLeaderboardWithOptions(1, LeaderboardOptions{Venue: entities.VenueHome})
+	if err != nil {
+		t.Fatalf("GenerateLeaderboardWithOptions() error = %v", err)
+	}
+	assertOrder(t, home, 2, 1, 3)
+	if home[0].Played != 2 || home[0].Points != 4 || home[1].Points != 3 || home[1].PointsDeducted != 0 {
+		t.Errorf("home table = %+v, want team 2 on 4 points from 2 matches and team 1 on 3", home)
+	}
+
+	away, err := service.GenerateLeaderboardWithOptions(1, LeaderboardOptions{Venue: entities.VenueAway})
+	if err != nil {
+		t.Fatalf("GenerateLeaderboardWithOptions() error = %v", err)
+	}
+	assertOrder(t, away, 3, 1, 2)
+	if away[0].Points != 1 || away[1].Played != 1 || away[1].Lost != 1 {
+		t.Errorf("away table = %+v, want team 3 on 1 point and team 1 beaten once", away)
+	}
+
+	if _, err := service.GenerateLeaderboardWithOptions(1, LeaderboardOptions{Venue: "neutral"}); err == nil {
+		t.Error("GenerateLeaderboardWithOptions() with an unknown venue should fail")
+	}
+}
+
+// TestLeaderboardService_VenueTiebreakers tests that the tiebreakers of a home table leave
+// out the away side of the matches, like the table itself
+func TestLeaderboardService_VenueTiebreakers(t *testing.T) {
+	repo := NewMockMatchRepository()
+	ruleSetService, _, _ := newTestRuleSetService()
+	service := NewLeaderboardService(repo, NewMockMatchPlayerRepository(repo), ruleSetService, NewMockTeamSanctionRepository())
+
+	rules := entities.DefaultRuleSet()
+	rules.Tiebreakers = []entities.Tiebreaker{entities.TiebreakerAwayGoals, entities.TiebreakerHeadToHead}
+	if err := ruleSetService.SetSeasonRules(1, rules); err != nil {
+		t.Fatalf("SetSeasonRules() error = %v", err)
+	}
+
+	// Both teams win at home by two goals; team 1 scored more away goals, while
+	// team 2 scored more goals at home
+	repo.Create(newFinishedMatch(1, 1, 2, 3, 1))
+	repo.Create(newFinishedMatch(1, 2, 1, 4, 2))
+
+	home, err := service.GenerateLeaderboardWithOptions(1, LeaderboardOptions{Venue: entities.VenueHome})
+	if err != nil {
+		t.Fatalf("GenerateLeaderboardWithOptions() error = %v", err)
+	}
+	assertOrder(t, home, 2, 1)
+}
@@ -0,0 +1,229 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"sort"
+)
+
+// Fair-play penalty points per card; the team with fewer points ranks higher
+const (
+	fairPlayYellowCardPoints = 1
+	fairPlayRedCardPoints    = 3
+)
+
+// standingsRanker orders leaderboard entries by points and then by the tiebreakers of a rule set
+type standingsRanker struct {
+	rules     *entities.RuleSet
+	venue     entities.Venue
+	matches   []entities.Match
+	awayGoals map[uint]int
+	fairPlay  map[uint]int
+}
+
+// newStandingsRanker creates a ranker for the given rules and the played league matches.
+// The tiebreakers of a home or away table only count the side of each match played at
+// that venue, like the table itself.
+func newStandingsRanker(rules *entities.RuleSet, matches []entities.Match, venue entities.Venue) *standingsRanker {
+	r := &standingsRanker{
+		rules:     rules,
+		venue:     venue,
+		matches:   matches,
+		awayGoals: make(map[uint]int),
+		fairPlay:  make(map[uint]int),
+	}
+
+	if r.counts(false) {
+		for _, match := range matches {
+			r.awayGoals[match.AwayTeamID] += *match.AwayTeamScore
+		}
+	}
+	return r
+}
+
+// counts reports whether the home or the away side of a match counts in the ranked table
+func (r *standingsRanker) counts(home bool) bool {
+	switch r.venue {
+	case entities.VenueHome:
+		return home
+	case entities.VenueAway:
+		return !home
+	}
+	return true
+}
+
+// needs reports whether the rules use the given tiebreaker
+func (r *standingsRanker) needs(tiebreaker entities.Tiebreaker) bool {
+	for _, t := range r.rules.Tiebreakers {
+		if t == tiebreaker {
+			return true
+		}
+	}
+	return false
+}
+
+// addCards accumulates the fair-play penalty points of the cards shown in the ranked matches
+func (r *standingsRanker) addCards(stats []entities.MatchPlayer) {
+	ranked := make(map[uint]entities.Match, len(r.matches))
+	for _, match := range r.matches {
+		ranked[match.ID] = match
+	}
+
+	for _, stat := range stats {
+		match, ok := ranked[stat.MatchID]
+		if !ok || !r.counts(stat.TeamID == match.HomeTeamID) {
+			continue
+		}
+		r.fairPlay[stat.TeamID] += stat.YellowCard*fairPlayYellowCardPoints + stat.RedCard*fairPlayRedCardPoints
+	}
+}
+
+// rank sorts the leaderboard by points and breaks ties between teams level on points
+func (r *standingsRanker) rank(leaderboard entities.Leaderboard) {
+	sort.SliceStable(leaderboard, func(i, j int) bool {
+		return leaderboard[i].Points > leaderboard[j].Points // More points is better
+	})
+
+	forEachTiedGroup(leaderboard, func(i, j int) bool {
+		return leaderboard[i].Points == leaderboard[j].Points
+	}, func(group entities.Leaderboard) {
+		r.resolve(group, r.rules.Tiebreakers)
+	})
+}
+
+// resolve orders a group of tied teams by the first tiebreaker and resolves the teams
+// that are still level with the remaining tiebreakers, falling back to the team name
+func (r *standingsRanker) resolve(group entities.Leaderboard, tiebreakers []entities.Tiebreaker) {
+	if len(group) < 2 {
+		return
+	}
+
+	if len(tiebreakers) == 0 {
+		sort.SliceStable(group, func(i, j int) bool {
+			return group[i].TeamName < group[j].TeamName // Alphabetical as a last resort
+		})
+		return
+	}
+
+	if tiebreakers[0] == entities.TiebreakerHeadToHead {
+		r.resolveHeadToHead(group, tiebreakers[1:])
+		return
+	}
+
+	keys := r.keys(group, tiebreakers[0])
+	sort.SliceStable(group, func(i, j int) bool {
+		return keys[group[i].TeamID] > keys[group[j].TeamID]
+	})
+
+	forEachTiedGroup(group, func(i, j int) bool {
+		return keys[group[i].TeamID] == keys[group[j].TeamID]
+	}, func(tied entities.Leaderboard) {
+		r.resolve(tied, tiebreakers[1:])
+	})
+}
+
+// keys computes the value of a tiebreaker for each team of a tied group; higher is better
+func (r *standingsRanker) keys(group entities.Leaderboard, tiebreaker entities.Tiebreaker) map[uint]int {
+	keys := make(map[uint]int, len(group))
+	for _, entry := range group {
+		switch tiebreaker {
+		case entities.TiebreakerGoalDifference:
+			keys[entry.TeamID] = entry.GoalDifference
+		case entities.TiebreakerGoalsFor:
+			keys[entry.TeamID] = entry.GoalsFor
+		case entities.TiebreakerWins:
+			keys[entry.TeamID] = entry.Won
+		case entities.TiebreakerAwayGoals:
+			keys[entry.TeamID] = r.awayGoals[entry.TeamID]
+		case entities.TiebreakerFairPlay:
+			keys[entry.TeamID] = -r.fairPlay[entry.TeamID]
+		}
+	}
+	return keys
+}
+
+// headToHeadRecord is a team's line in a mini-table built from the matches between tied teams
+type headToHeadRecord struct {
+	Points         int
+	GoalDifference int
+	GoalsFor       int
+}
+
+// better reports whether the record ranks above the other one
+func (h headToHeadRecord) better(other headToHeadRecord) bool {
+	if h.Points != other.Points {
+		return h.Points > other.Points
+	}
+	if h.GoalDifference != other.GoalDifference {
+		return h.GoalDifference > other.GoalDifference
+	}
+	return h.GoalsFor > other.GoalsFor
+}
+
+// resolveHeadToHead ranks a tied group on a mini-table of the matches played between its
+// teams only: points, then goal difference, then goals scored. When this separates some of
+// the teams, the procedure is applied again to each subgroup that is still level, using only
+// the matches among that subgroup. Teams the mini-table cannot separate at all are handed
+// over to the remaining tiebreakers.
+func (r *standingsRanker) resolveHeadToHead(group entities.Leaderboard, remaining []entities.Tiebreaker) {
+	table := r.headToHeadTable(group)
+	sort.SliceStable(group, func(i, j int) bool {
+		return table[group[i].TeamID].better(table[group[j].TeamID])
+	})
+
+	forEachTiedGroup(group, func(i, j int) bool {
+		return table[group[i].TeamID] == table[group[j].TeamID]
+	}, func(tied entities.Leaderboard) {
+		if len(tied) < len(group) {
+			r.resolveHeadToHead(tied, remaining)
+			return
+		}
+		r.resolve(tied, remaining)
+	})
+}
+
+// headToHeadTable builds the mini-table of the matches played between the teams of the group
+func (r *standingsRanker) headToHeadTable(group entities.Leaderboard) map[uint]headToHeadRecord {
+	table := make(map[uint]headToHeadRecord, len(group))
+	for _, entry := range group {
+		table[entry.TeamID] = headToHeadRecord{}
+	}
+
+	for _, match := range r.matches {
+		home, homeInGroup := table[match.HomeTeamID]
+		away, awayInGroup := table[match.AwayTeamID]
+		if !homeInGroup || !awayInGroup {
+			continue
+		}
+
+		homeScore, awayScore := *match.HomeTeamScore, *match.AwayTeamScore
+		if r.counts(true) {
+			home.Points += r.rules.Points(homeScore, awayScore)
+			home.GoalDifference += homeScore - awayScore
+			home.GoalsFor += homeScore
+		}
+		if r.counts(false) {
+			away.Points += r.rules.Points(awayScore, homeScore)
+			away.GoalDifference += awayScore - homeScore
+			away.GoalsFor += awayScore
+		}
+
+		table[match.HomeTeamID] = home
+		table[match.AwayTeamID] = away
+	}
+	return table
+}
+
+// forEachTiedGroup calls fn for every run of two or more consecutive entries that tie
+// considers level with the first entry of the run
+func forEachTiedGroup(leaderboard entities.Leaderboard, tie func(i, j int) bool, fn func(group entities.Leaderboard)) {
+	for start := 0; start < len(leaderboard); {
+		end := start + 1
+		for end < len(leaderboard) && tie(start, end) {
+			end++
+		}
+		if end-start > 1 {
+			fn(leaderboard[start:end])
+		}
+		start = end
+	}
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"sort"
+)
+
+// MockLeagueRepository is an in-memory implementation of LeagueRepository for testing
+type MockLeagueRepository struct {
+	leagues map[uint]*entities.League
+	nextID  uint
+}
+
+// NewMockLeagueRepository creates a new mock league repository
+func NewMockLeagueRepository() *MockLeagueRepository {
+	return &MockLeagueRepository{
+		leagues: make(map[uint]*entities.League),
+		nextID:  1,
+	}
+}
+
+func (m *MockLeagueRepository) Create(league *entities.League) error {
+	if league.ID == 0 {
+		league.ID = m.nextID
+	}
+	if league.ID >= m.nextID {
+		m.nextID = league.ID + 1
+	}
+	stored := *league
+	m.leagues[league.ID] = &stored
+	return nil
+}
+
+func (m *MockLeagueRepository) GetByID(id uint) (*entities.League, error) {
+	if league, exists := m.leagues[id]; exists {
+		found := *league
+		return &found, nil
+	}
+	return nil, errors.New("record not found")
+}
+
+func (m *MockLeagueRepository) GetAll() ([]entities.League, error) {
+	leagues := make([]entities.League, 0, len(m.leagues))
+	for _, league := range m.leagues {
+		leagues = append(leagues, *league)
+	}
+	sort.Slice(leagues, func(i, j int) bool { return leagues[i].ID < leagues[j].ID })
+	return leagues, nil
+}
+
+func (m *MockLeagueRepository) Update(league *entities.League) error {
+	if _, exists := m.leagues[league.ID]; !exists {
+		return errors.New("record not found")
+	}
+	stored := *league
+	m.leagues[league.ID] = &stored
+	return nil
+}
+
+func (m *MockLeagueRepository) Delete(id uint) error {
+	delete(m.leagues, id)
+	return nil
+}
+
+func (m *MockLeagueRepository) GetWithSeasons(id uint) (*entities.League, error) {
+	return m.GetByID(id)
+}
@@ -24,6 +24,10 @@ func (s *LeagueService) CreateLeague(league *entities.League) error {
 		return errors.New("league name is required")
 	}
 	
+	if league.Tier < 0 {
+		return errors.New("league tier cannot be negative")
+	}
+	
 	return s.leagueRepo.Create(league)
 }
 
@@ -60,6 +64,10 @@ func (s *LeagueService) UpdateLeague(league *entities.League) error {
 		return errors.New("league name is required")
 	}
 	
+	if league.Tier < 0 {
+		return errors.New("league tier cannot be negative")
+	}
+	
 	return s.leagueRepo.Update(league)
 }
 
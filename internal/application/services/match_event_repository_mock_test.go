@@ -0,0 +1,77 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"sort"
+)
+
+// MockMatchEventRepository is an in-memory implementation of MatchEventRepository for testing
+type MockMatchEventRepository struct {
+	events          map[uint]*entities.MatchEvent
+	nextID          uint
+	matchRepo       *MockMatchRepository
+	matchPlayerRepo *MockMatchPlayerRepository
+}
+
+// NewMockMatchEventRepository creates a new mock match event repository that stores the
+// derived statistics and results in matchPlayerRepo and matchRepo
+func NewMockMatchEventRepository(matchRepo *MockMatchRepository, matchPlayerRepo *MockMatchPlayerRepository) *MockMatchEventRepository {
+	return &MockMatchEventRepository{
+		events:          make(map[uint]*entities.MatchEvent),
+		nextID:          1,
+		matchRepo:       matchRepo,
+		matchPlayerRepo: matchPlayerRepo,
+	}
+}
+
+func (m *MockMatchEventRepository) Save(event *entities.MatchEvent, stats []entities.MatchPlayer, match *entities.Match) error {
+	if event.ID == 0 {
+		event.ID = m.nextID
+		m.nextID++
+	} else if _, exists := m.events[event.ID]; !exists {
+		return errors.New("record not found")
+	}
+	stored := *event
+	m.events[event.ID] = &stored
+	return m.saveTimelineResult(stats, match)
+}
+
+func (m *MockMatchEventRepository) GetByID(id uint) (*entities.MatchEvent, error) {
+	if event, exists := m.events[id]; exists {
+		found := *event
+		return &found, nil
+	}
+	return nil, errors.New("record not found")
+}
+
+func (m *MockMatchEventRepository) Remove(id uint, stats []entities.MatchPlayer, match *entities.Match) error {
+	delete(m.events, id)
+	return m.saveTimelineResult(stats, match)
+}
+
+func (m *MockMatchEventRepository) saveTimelineResult(stats []entities.MatchPlayer, match *entities.Match) error {
+	if err := m.matchPlayerRepo.sync(match.ID, stats); err != nil {
+		return err
+	}
+	return m.matchRepo.SaveResult(match, false)
+}
+
+func (m *MockMatchEventRepository) GetByMatchID(matchID uint) ([]entities.MatchEvent, error) {
+	events := make([]entities.MatchEvent, 0)
+	for _, event := range m.events {
+		if event.MatchID == matchID {
+			events = append(events, *event)
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].Minute != events[j].Minute {
+			return events[i].Minute < events[j].Minute
+		}
+		if events[i].AddedTime != events[j].AddedTime {
+			return events[i].AddedTime < events[j].AddedTime
+		}
+		return events[i].ID < events[j].ID
+	})
+	return events, nil
+}
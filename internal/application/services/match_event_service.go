@@ -0,0 +1,343 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"errors"
+	"sort"
+)
+
+// maxEventMinute is the last regular minute an event can be recorded at, extra time included
+const maxEventMinute = 120
+
+// MatchEventService handles the timeline of a match. Player statistics and the match
+// score are derived from the events and saved in the same transaction as every change,
+// so they never drift apart. The players of an event must be eligible for the match just
+// like players given statistics directly.
+type MatchEventService struct {
+	eventRepo          repositories.MatchEventRepository
+	matchRepo          repositories.MatchRepository
+	matchService       *MatchService
+	matchPlayerService *MatchPlayerService
+}
+
+// NewMatchEventService creates a new match event service instance
+func NewMatchEventService(eventRepo repositories.MatchEventRepository, matchRepo repositories.MatchRepository, matchService *MatchService, matchPlayerService *MatchPlayerService) *MatchEventService {
+	return &MatchEventService{
+		eventRepo:          eventRepo,
+		matchRepo:          matchRepo,
+		matchService:       matchService,
+		matchPlayerService: matchPlayerService,
+	}
+}
+
+// CreateEvent adds an event to the timeline of a match
+func (s *MatchEventService) CreateEvent(matchID uint, event *entities.MatchEvent) error {
+	match, err := s.getMatch(matchID)
+	if err != nil {
+		return err
+	}
+
+	event.ID = 0
+	event.MatchID = matchID
+	if err := validateMatchEvent(match, event); err != nil {
+		return err
+	}
+
+	if err := s.checkEventEligibility(match, event); err != nil {
+		return err
+	}
+
+	events, err := s.eventRepo.GetByMatchID(matchID)
+	if err != nil {
+		return err
+	}
+
+	return s.saveEvent(match, event, append(events, *event))
+}
+
+// GetEventsByMatchID retrieves the timeline of a match in chronological order
+func (s *MatchEventService) GetEventsByMatchID(matchID uint) ([]entities.MatchEvent, error) {
+	if matchID == 0 {
+		return nil, errors.New("invalid match ID")
+	}
+
+	return s.eventRepo.GetByMatchID(matchID)
+}
+
+// UpdateEvent updates an event of a match timeline
+func (s *MatchEventService) UpdateEvent(matchID uint, event *entities.MatchEvent) error {
+	match, err := s.getMatch(matchID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.getEvent(matchID, event.ID); err != nil {
+		return err
+	}
+
+	event.MatchID = matchID
+	if err := validateMatchEvent(match, event); err != nil {
+		return err
+	}
+
+	if err := s.checkEventEligibility(match, event); err != nil {
+		return err
+	}
+
+	events, err := s.eventRepo.GetByMatchID(matchID)
+	if err != nil {
+		return err
+	}
+	for i := range events {
+		if events[i].ID == event.ID {
+			events[i] = *event
+		}
+	}
+
+	return s.saveEvent(match, event, events)
+}
+
+// DeleteEvent removes an event from a match timeline
+func (s *MatchEventService) DeleteEvent(matchID, eventID uint) error {
+	match, err := s.getMatch(matchID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.getEvent(matchID, eventID); err != nil {
+		return err
+	}
+
+	events, err := s.eventRepo.GetByMatchID(matchID)
+	if err != nil {
+		return err
+	}
+	remaining := make([]entities.MatchEvent, 0, len(events))
+	for _, event := range events {
+		if event.ID != eventID {
+			remaining = append(remaining, event)
+		}
+	}
+
+	stats, err := s.deriveResult(match, remaining)
+	if err != nil {
+		return err
+	}
+	if err := s.eventRepo.Remove(eventID, stats, match); err != nil {
+		return err
+	}
+
+	s.notifyResult(match)
+	return nil
+}
+
+// getMatch retrieves the match an event belongs to. The timeline of a match settled with an
+// administrative result cannot change, as it would overwrite the awarded score.
+func (s *MatchEventService) getMatch(matchID uint) (*entities.Match, error) {
+	if matchID == 0 {
+		return nil, errors.New("invalid match ID")
+	}
+
+	match, err := s.matchRepo.GetByID(matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	if match.IsAdministrative() {
+		return nil, ErrAdministrativeResult
+	}
+
+	return match, nil
+}
+
+// getEvent retrieves an event and checks that it belongs to the match
+func (s *MatchEventService) getEvent(matchID, eventID uint) (*entities.MatchEvent, error) {
+	if eventID == 0 {
+		return nil, errors.New("invalid match event ID")
+	}
+
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if event.MatchID != matchID {
+		return nil, errors.New("match event does not belong to this match")
+	}
+
+	return event, nil
+}
+
+// checkEventEligibility checks the players of an event with the rules for match player
+// statistics. Violations of the secondary player are reported against secondary_player_id;
+// the team is shared with the player and only reported once.
+func (s *MatchEventService) checkEventEligibility(match *entities.Match, event *entities.MatchEvent) error {
+	err := s.matchPlayerService.checkEligibility(&entities.MatchPlayer{MatchID: match.ID, TeamID: event.TeamID, PlayerID: event.PlayerID}, match)
+	var eligibility *EligibilityError
+	if event.SecondaryPlayerID == nil || (err != nil && !errors.As(err, &eligibility)) {
+		return err
+	}
+
+	secondaryErr := s.matchPlayerService.checkEligibility(&entities.MatchPlayer{MatchID: match.ID, TeamID: event.TeamID, PlayerID: *event.SecondaryPlayerID}, match)
+	var secondary *EligibilityError
+	if !errors.As(secondaryErr, &secondary) {
+		if secondaryErr != nil {
+			return secondaryErr
+		}
+		return err
+	}
+
+	violations := make([]EligibilityViolation, 0)
+	if eligibility != nil {
+		violations = append(violations, eligibility.Violations...)
+	}
+	for _, violation := range secondary.Violations {
+		if violation.Field != "player_id" {
+			continue
+		}
+		violation.Field = "secondary_player_id"
+		violations = append(violations, violation)
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	return &EligibilityError{Violations: violations}
+}
+
+// saveEvent stores a new or changed event together with the player statistics and the
+// result derived from the resulting timeline, then runs the match hooks
+func (s *MatchEventService) saveEvent(match *entities.Match, event *entities.MatchEvent, events []entities.MatchEvent) error {
+	stats, err := s.deriveResult(match, events)
+	if err != nil {
+		return err
+	}
+	if err := s.eventRepo.Save(event, stats, match); err != nil {
+		return err
+	}
+
+	s.notifyResult(match)
+	return nil
+}
+
+// deriveResult sets the score and points of a match from a timeline and returns the player
+// statistics of that timeline; nothing is saved
+func (s *MatchEventService) deriveResult(match *entities.Match, events []entities.MatchEvent) ([]entities.MatchPlayer, error) {
+	stats, homeScore, awayScore := aggregateMatchEvents(match, events)
+	match.HomeTeamScore = &homeScore
+	match.AwayTeamScore = &awayScore
+	if err := validateKnockoutDeciders(match); err != nil {
+		return nil, err
+	}
+	if err := s.matchService.awardPoints(match); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// notifyResult runs the match hooks once a timeline change has been saved
+func (s *MatchEventService) notifyResult(match *entities.Match) {
+	s.matchService.notifyUpdated(entities.MatchUpdateScore, match)
+	s.matchService.notifyFinished(match)
+}
+
+// aggregateMatchEvents computes the per-player statistics and the score from a match timeline.
+// Own goals count for the opposing team; every player on the timeline gets a statistics row.
+func aggregateMatchEvents(match *entities.Match, events []entities.MatchEvent) ([]entities.MatchPlayer, int, int) {
+	byPlayer := make(map[uint]*entities.MatchPlayer)
+	playerStat := func(playerID, teamID uint) *entities.MatchPlayer {
+		if _, ok := byPlayer[playerID]; !ok {
+			byPlayer[playerID] = &entities.MatchPlayer{MatchID: match.ID, TeamID: teamID, PlayerID: playerID}
+		}
+		return byPlayer[playerID]
+	}
+
+	homeScore, awayScore := 0, 0
+	for _, event := range events {
+		stat := playerStat(event.PlayerID, event.TeamID)
+		var secondary *entities.MatchPlayer
+		if event.SecondaryPlayerID != nil {
+			secondary = playerStat(*event.SecondaryPlayerID, event.TeamID)
+		}
+
+		switch event.Type {
+		case entities.MatchEventGoal, entities.MatchEventPenaltyGoal:
+			stat.Goals++
+			if secondary != nil {
+				secondary.Assists++
+			}
+		case entities.MatchEventOwnGoal:
+			stat.OwnGoals++
+		case entities.MatchEventYellowCard:
+			stat.YellowCard++
+		case entities.MatchEventRedCard:
+			stat.RedCard++
+		}
+
+		if !event.IsGoal() {
+			continue
+		}
+
+		scoredForHome := event.TeamID == match.HomeTeamID
+		if event.Type == entities.MatchEventOwnGoal {
+			scoredForHome = !scoredForHome
+		}
+		if scoredForHome {
+			homeScore++
+		} else {
+			awayScore++
+		}
+	}
+
+	stats := make([]entities.MatchPlayer, 0, len(byPlayer))
+	for _, stat := range byPlayer {
+		stats = append(stats, *stat)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].PlayerID < stats[j].PlayerID })
+
+	return stats, homeScore, awayScore
+}
+
+// validateMatchEvent checks an event against the match it is recorded for
+func validateMatchEvent(match *entities.Match, event *entities.MatchEvent) error {
+	switch event.Type {
+	case entities.MatchEventGoal, entities.MatchEventPenaltyGoal, entities.MatchEventOwnGoal,
+		entities.MatchEventYellowCard, entities.MatchEventRedCard, entities.MatchEventSubstitution:
+	default:
+		return errors.New("invalid match event type")
+	}
+
+	if event.PlayerID == 0 {
+		return errors.New("player ID is required")
+	}
+
+	if event.TeamID != match.HomeTeamID && event.TeamID != match.AwayTeamID {
+		return errors.New("team must be the home or away team of the match")
+	}
+
+	if event.Minute < 0 || event.Minute > maxEventMinute {
+		return errors.New("minute must be between 0 and 120")
+	}
+
+	if event.AddedTime < 0 {
+		return errors.New("added time cannot be negative")
+	}
+
+	if event.SecondaryPlayerID != nil && *event.SecondaryPlayerID == event.PlayerID {
+		return errors.New("secondary player must be different from the player")
+	}
+
+	switch event.Type {
+	case entities.MatchEventSubstitution:
+		if event.SecondaryPlayerID == nil {
+			return errors.New("substitution requires the player coming off as secondary player")
+		}
+	case entities.MatchEventGoal, entities.MatchEventPenaltyGoal:
+	default:
+		if event.SecondaryPlayerID != nil {
+			return errors.New("only goals and substitutions can have a secondary player")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,249 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"testing"
+	"time"
+)
+
+// newTestMatchEventService creates a match event service around a single in-progress match
+// between teams 1 and 2, with players 10 to 15 at team 1 and players 20 to 25 at team 2
+func newTestMatchEventService(t *testing.T) (*MatchEventService, *MockMatchRepository, *MockMatchPlayerRepository, *entities.Match) {
+	t.Helper()
+	matchRepo := NewMockMatchRepository()
+	matchPlayerRepo := NewMockMatchPlayerRepository(matchRepo)
+	ruleSetService, _, seasonRepo := newTestRuleSetService()
+	enrollTeams(seasonRepo, 1, 1, 2)
+	playerRepo := NewMockPlayerRepository()
+	for id := uint(10); id <= 15; id++ {
+		playerRepo.Create(&entities.Player{ID: id, TeamID: 1, Number: int(id)})
+		playerRepo.Create(&entities.Player{ID: id + 10, TeamID: 2, Number: int(id)})
+	}
+	matchService := NewMatchService(matchRepo, ruleSetService)
+	transferService := NewTransferService(NewMockPlayerRegistrationRepository(playerRepo), NewMockTransferWindowRepository(), playerRepo, seasonRepo)
+	disciplineService := NewDisciplineService(matchRepo, matchPlayerRepo, ruleSetService)
+	matchPlayerService := NewMatchPlayerService(matchPlayerRepo, matchRepo, seasonRepo, transferService, disciplineService)
+
+	match := newFinishedMatch(1, 1, 2, 0, 0)
+	match.Status = string(entities.MatchStatusInProgress)
+	if err := matchRepo.Create(match); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	service := NewMatchEventService(NewMockMatchEventRepository(matchRepo, matchPlayerRepo), matchRepo, matchService, matchPlayerService)
+	return service, matchRepo, matchPlayerRepo, match
+}
+
+// playerStats indexes the statistics rows of a match by player
+func playerStats(t *testing.T, repo *MockMatchPlayerRepository, matchID uint) map[uint]entities.MatchPlayer {
+	t.Helper()
+	stats, err := repo.GetByMatchID(matchID)
+	if err != nil {
+		t.Fatalf("GetByMatchID() error = %v", err)
+	}
+	byPlayer := make(map[uint]entities.MatchPlayer, len(stats))
+	for _, stat := range stats {
+		byPlayer[stat.PlayerID] = stat
+	}
+	return byPlayer
+}
+
+// TestMatchEventService_DerivesStatsAndScore tests that goals, assists, own goals and cards
+// recorded on the timeline produce the player statistics and the match score
+func TestMatchEventService_DerivesStatsAndScore(t *testing.T) {
+	service, matchRepo, matchPlayerRepo, match := newTestMatchEventService(t)
+
+	assist := uint(11)
+	events := []entities.MatchEvent{
+		{TeamID: 1, PlayerID: 10, SecondaryPlayerID: &assist, Type: entities.MatchEventGoal, Minute: 12},
+		{TeamID: 2, PlayerID: 20, Type: entities.MatchEventYellowCard, Minute: 30},
+		{TeamID: 1, PlayerID: 12, Type: entities.MatchEventOwnGoal, Minute: 45, AddedTime: 2},
+		{TeamID: 1, PlayerID: 10, Type: entities.MatchEventPenaltyGoal, Minute: 80},
+		{TeamID: 2, PlayerID: 21, Type: entities.MatchEventRedCard, Minute: 88},
+	}
+	for i := range events {
+		if err := service.CreateEvent(match.ID, &events[i]); err != nil {
+			t.Fatalf("CreateEvent() error = %v", err)
+		}
+	}
+
+	updated, _ := matchRepo.GetByID(match.ID)
+	if *updated.HomeTeamScore != 2 || *updated.AwayTeamScore != 1 {
+		t.Errorf("score = %d-%d, want 2-1", *updated.HomeTeamScore, *updated.AwayTeamScore)
+	}
+
+	stats := playerStats(t, matchPlayerRepo, match.ID)
+	if stats[10].Goals != 2 || stats[11].Assists != 1 || stats[12].OwnGoals != 1 {
+		t.Errorf("goals = %d, assists = %d, own goals = %d, want 2, 1 and 1", stats[10].Goals, stats[11].Assists, stats[12].OwnGoals)
+	}
+	if stats[20].YellowCard != 1 || stats[21].RedCard != 1 {
+		t.Errorf("yellow cards = %d, red cards = %d, want 1 and 1", stats[20].YellowCard, stats[21].RedCard)
+	}
+
+	timeline, _ := service.GetEventsByMatchID(match.ID)
+	if len(timeline) != len(events) || timeline[2].Type != entities.MatchEventOwnGoal {
+		t.Errorf("timeline should list the %d events in chronological order", len(events))
+	}
+
+	// Removing the penalty takes the goal back from the scorer and the score
+	if err := service.DeleteEvent(match.ID, events[3].ID); err != nil {
+		t.Fatalf("DeleteEvent() error = %v", err)
+	}
+	updated, _ = matchRepo.GetByID(match.ID)
+	if *updated.HomeTeamScore != 1 || *updated.AwayTeamScore != 1 {
+		t.Errorf("score after delete = %d-%d, want 1-1", *updated.HomeTeamScore, *updated.AwayTeamScore)
+	}
+	if stats := playerStats(t, matchPlayerRepo, match.ID); stats[10].Goals != 1 {
+		t.Errorf("goals after delete = %d, want 1", stats[10].Goals)
+	}
+
+	// Correcting the scorer moves the goal to the other player
+	events[0].PlayerID = 13
+	if err := service.UpdateEvent(match.ID, &events[0]); err != nil {
+		t.Fatalf("UpdateEvent() error = %v", err)
+	}
+	stats = playerStats(t, matchPlayerRepo, match.ID)
+	if stats[10].Goals != 0 || stats[13].Goals != 1 {
+		t.Errorf("goals after update = %d and %d, want 0 and 1", stats[10].Goals, stats[13].Goals)
+	}
+}
+
+// TestMatchEventService_Validation tests that invalid events are rejected
+func TestMatchEventService_Validation(t *testing.T) {
+	service, _, _, match := newTestMatchEventService(t)
+
+	outgoing := uint(15)
+	tests := []struct {
+		name  string
+		event entities.MatchEvent
+	}{
+		{name: "unknown type", event: entities.MatchEvent{TeamID: 1, PlayerID: 10, Type: "corner"}},
+		{name: "missing player", event: entities.MatchEvent{TeamID: 1, Type: entities.MatchEventGoal}},
+		{name: "team not in match", event: entities.MatchEvent{TeamID: 3, PlayerID: 10, Type: entities.MatchEventGoal}},
+		{name: "minute out of range", event: entities.MatchEvent{TeamID: 1, PlayerID: 10, Type: entities.MatchEventGoal, Minute: 121}},
+		{name: "substitution without player off", event: entities.MatchEvent{TeamID: 1, PlayerID: 10, Type: entities.MatchEventSubstitution}},
+		{name: "card with secondary player", event: entities.MatchEvent{TeamID: 1, PlayerID: 10, SecondaryPlayerID: &outgoing, Type: entities.MatchEventYellowCard}},
+	}
+
+	for _, tt := range tests {
+		if err := service.CreateEvent(match.ID, &tt.event); err == nil {
+			t.Errorf("CreateEvent() with %s should fail", tt.name)
+		}
+	}
+}
+
+// TestMatchEventService_NothingSavedWhenResultFails tests that an event is not stored when
+// the result of its match cannot be settled, so the timeline never disagrees with the score
+func TestMatchEventService_NothingSavedWhenResultFails(t *testing.T) {
+	service, matchRepo, matchPlayerRepo, _ := newTestMatchEventService(t)
+	orphan := newFinishedMatch(1, 1, 2, 0, 0)
+	orphan.Status = string(entities.MatchStatusInProgress)
+	extraTime := 0
+	orphan.HomeExtraTimeScore, orphan.AwayExtraTimeScore = &extraTime, &extraTime
+	matchRepo.Create(orphan)
+
+	if err := service.CreateEvent(orphan.ID, &entities.MatchEvent{TeamID: 1, PlayerID: 10, Type: entities.MatchEventGoal}); err == nil {
+		t.Fatal("CreateEvent() for a regular match with extra time should fail")
+	}
+
+	events, _ := service.GetEventsByMatchID(orphan.ID)
+	stored, _ := matchRepo.GetByID(orphan.ID)
+	if len(events) != 0 || len(playerStats(t, matchPlayerRepo, orphan.ID)) != 0 || *stored.HomeTeamScore != 0 {
+		t.Errorf("got %d events and a %d-%d score, want nothing saved", len(events), *stored.HomeTeamScore, *stored.AwayTeamScore)
+	}
+}
+
+// TestMatchEventService_ChecksEligibility tests that events are only recorded for players
+// eligible for the match, the assisting or outgoing player included
+func TestMatchEventService_ChecksEligibility(t *testing.T) {
+	service, matchRepo, matchPlayerRepo, match := newTestMatchEventService(t)
+	earlier := newFinishedMatch(1, 1, 2, 0, 0)
+	earlier.Date = time.Date(2025, 2, 22, 15, 0, 0, 0, time.UTC)
+	matchRepo.Create(earlier)
+	matchPlayerRepo.Create(&entities.MatchPlayer{MatchID: earlier.ID, TeamID: 1, PlayerID: 11, RedCard: 1})
+
+	outsider := uint(20)
+	tests := []struct {
+		name  string
+		event entities.MatchEvent
+		want  EligibilityViolation
+	}{
+		{
+			name:  "player of the other side",
+			event: entities.MatchEvent{TeamID: 1, PlayerID: 20, Type: entities.MatchEventGoal, Minute: 10},
+			want:  EligibilityViolation{Code: ViolationPlayerNotRegistered, Field: "player_id"},
+		},
+		{
+			name:  "assist by a player of the other side",
+			event: entities.MatchEvent{TeamID: 1, PlayerID: 10, SecondaryPlayerID: &outsider, Type: entities.MatchEventGoal, Minute: 10},
+			want:  EligibilityViolation{Code: ViolationPlayerNotRegistered, Field: "secondary_player_id"},
+		},
+		{
+			name:  "suspended player",
+			event: entities.MatchEvent{TeamID: 1, PlayerID: 11, Type: entities.MatchEventYellowCard, Minute: 10},
+			want:  EligibilityViolation{Code: ViolationPlayerSuspended, Field: "player_id"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := tt.event
+			err := service.CreateEvent(match.ID, &event)
+
+			var eligibility *EligibilityError
+			if !errors.As(err, &eligibility) {
+				t.Fatalf("CreateEvent() error = %v, want an EligibilityError", err)
+			}
+			if len(eligibility.Violations) != 1 || eligibility.Violations[0].Code != tt.want.Code || eligibility.Violations[0].Field != tt.want.Field {
+				t.Errorf("got violations %+v, want %s on %s", eligibility.Violations, tt.want.Code, tt.want.Field)
+			}
+		})
+	}
+
+	goal := entities.MatchEvent{TeamID: 1, PlayerID: 10, Type: entities.MatchEventGoal, Minute: 10}
+	if err := service.CreateEvent(match.ID, &goal); err != nil {
+		t.Fatalf("CreateEvent() for an eligible player error = %v", err)
+	}
+	goal.PlayerID = 11
+	if err := service.UpdateEvent(match.ID, &goal); !errors.Is(err, ErrPlayerSuspended) {
+		t.Errorf("UpdateEvent() to a suspended player error = %v, want ErrPlayerSuspended", err)
+	}
+	if len(playerStats(t, matchPlayerRepo, match.ID)) != 1 {
+		t.Errorf("only the eligible scorer should have statistics")
+	}
+}
+
+// TestMatchEventService_AdministrativeResultIsLocked tests that the timeline of a match settled
+// with an administrative result cannot change its awarded score
+func TestMatchEventService_AdministrativeResultIsLocked(t *testing.T) {
+	service, matchRepo, _, match := newTestMatchEventService(t)
+	goal := entities.MatchEvent{TeamID: 1, PlayerID: 10, Type: entities.MatchEventGoal, Minute: 10}
+	if err := service.CreateEvent(match.ID, &goal); err != nil {
+		t.Fatalf("CreateEvent() error = %v", err)
+	}
+
+	awarded, _ := matchRepo.GetByID(match.ID)
+	homeScore, awayScore := 0, 3
+	awarded.Status = string(entities.MatchStatusFinished)
+	awarded.AdministrativeResult = entities.AdministrativeAwarded
+	awarded.HomeTeamScore, awarded.AwayTeamScore = &homeScore, &awayScore
+	matchRepo.Update(awarded)
+
+	another := entities.MatchEvent{TeamID: 1, PlayerID: 11, Type: entities.MatchEventGoal, Minute: 20}
+	if err := service.CreateEvent(match.ID, &another); !errors.Is(err, ErrAdministrativeResult) {
+		t.Errorf("CreateEvent() error = %v, want ErrAdministrativeResult", err)
+	}
+	goal.Minute = 15
+	if err := service.UpdateEvent(match.ID, &goal); !errors.Is(err, ErrAdministrativeResult) {
+		t.Errorf("UpdateEvent() error = %v, want ErrAdministrativeResult", err)
+	}
+	if err := service.DeleteEvent(match.ID, goal.ID); !errors.Is(err, ErrAdministrativeResult) {
+		t.Errorf("DeleteEvent() error = %v, want ErrAdministrativeResult", err)
+	}
+
+	stored, _ := matchRepo.GetByID(match.ID)
+	if *stored.HomeTeamScore != 0 || *stored.AwayTeamScore != 3 {
+		t.Errorf("score = %d-%d, want the awarded 0-3 kept", *stored.HomeTeamScore, *stored.AwayTeamScore)
+	}
+}
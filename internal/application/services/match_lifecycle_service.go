@@ -0,0 +1,171 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrInvalidMatchTransition is returned when a match cannot move to the requested status
+var ErrInvalidMatchTransition = errors.New("invalid match status transition")
+
+// MatchLifecycleService moves matches through their statuses and records when each phase started
+type MatchLifecycleService struct {
+	matchRepo    repositories.MatchRepository
+	matchService *MatchService
+}
+
+// NewMatchLifecycleService creates a new match lifecycle service instance
+func NewMatchLifecycleService(matchRepo repositories.MatchRepository, matchService *MatchService) *MatchLifecycleService {
+	return &MatchLifecycleService{
+		matchRepo:    matchRepo,
+		matchService: matchService,
+	}
+}
+
+// Start kicks off a scheduled match with a 0-0 score, or starts the second half after half time
+func (s *MatchLifecycleService) Start(matchID uint) (*entities.Match, error) {
+	return s.transition(matchID, entities.MatchStatusInProgress, func(match *entities.Match, now time.Time) {
+		if match.KickedOffAt != nil {
+			match.SecondHalfStartedAt = &now
+			return
+		}
+		match.KickedOffAt = &now
+		if match.HomeTeamScore == nil {
+			homeScore := 0
+			match.HomeTeamScore = &homeScore
+		}
+		if match.AwayTeamScore == nil {
+			awayScore := 0
+			match.AwayTeamScore = &awayScore
+		}
+	})
+}
+
+// HalfTime pauses a match in progress at the end of the first half
+func (s *MatchLifecycleService) HalfTime(matchID uint) (*entities.Match, error) {
+	return s.transition(matchID, entities.MatchStatusHalfTime, func(match *entities.Match, now time.Time) {
+		match.HalfTimeAt = &now
+	})
+}
+
+// Finish ends a match in progress and settles its points with the current score. A match
+// created in progress has no score, in which case it ends 0-0.
+// The status, score and points are saved together before the finished hooks run.
+func (s *MatchLifecycleService) Finish(matchID uint) (*entities.Match, error) {
+	match, err := s.load(matchID, entities.MatchStatusFinished)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	match.Status = string(entities.MatchStatusFinished)
+	match.FinishedAt = &now
+	if match.HomeTeamScore == nil {
+		homeScore := 0
+		match.HomeTeamScore = &homeScore
+	}
+	if match.AwayTeamScore == nil {
+		awayScore := 0
+		match.AwayTeamScore = &awayScore
+	}
+
+	if err := s.matchService.settle(match, false); err != nil {
+		return nil, err
+	}
+
+	s.matchService.notifyUpdated(entities.MatchUpdateStatus, match)
+	s.matchService.notifyUpdated(entities.MatchUpdateScore, match)
+	s.matchService.notifyFinished(match)
+
+	return s.matchRepo.GetByID(match.ID)
+}
+
+// Postpone postpones a scheduled match
+func (s *MatchLifecycleService) Postpone(matchID uint) (*entities.Match, error) {
+	return s.transition(matchID, entities.MatchStatusPostponed, func(match *entities.Match, now time.Time) {
+		match.PostponedAt = &now
+	})
+}
+
+// Reschedule puts a postponed match back on the schedule, optionally on a new date
+func (s *MatchLifecycleService) Reschedule(matchID uint, date time.Time) (*entities.Match, error) {
+	return s.transition(matchID, entities.MatchStatusScheduled, func(match *entities.Match, now time.Time) {
+		if !date.IsZero() {
+			match.Date = date
+		}
+	})
+}
+
+// Cancel cancels a scheduled or postponed match
+func (s *MatchLifecycleService) Cancel(matchID uint) (*entities.Match, error) {
+	return s.transition(matchID, entities.MatchStatusCancelled, func(match *entities.Match, now time.Time) {
+		match.CancelledAt = &now
+	})
+}
+
+// Abandon stops a match that could not be played to the end
+func (s *MatchLifecycleService) Abandon(matchID uint) (*entities.Match, error) {
+	return s.transition(matchID, entities.MatchStatusAbandoned, func(match *entities.Match, now time.Time) {
+		match.AbandonedAt = &now
+	})
+}
+
+// transition checks that the match can move to the next status, applies the phase changes and saves it
+func (s *MatchLifecycleService) transition(matchID uint, next entities.MatchStatus, apply func(match *entities.Match, now time.Time)) (*entities.Match, error) {
+	match, err := s.load(matchID, next)
+	if err != nil {
+		return nil, err
+	}
+
+	match.Status = string(next)
+	apply(match, time.Now())
+
+	if err := s.matchRepo.Update(match); err != nil {
+		return nil, err
+	}
+
+	s.matchService.notifyUpdated(entities.MatchUpdateStatus, match)
+	return match, nil
+}
+
+// load retrieves a match and checks that it can move to the next status
+func (s *MatchLifecycleService) load(matchID uint, next entities.MatchStatus) (*entities.Match, error) {
+	if matchID == 0 {
+		return nil, errors.New("invalid match ID")
+	}
+
+	match, err := s.matchRepo.GetByID(matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	if matchStatus(match) == next {
+		return nil, fmt.Errorf("%w: match is already %s", ErrInvalidMatchTransition, next)
+	}
+	if err := checkMatchTransition(match, next); err != nil {
+		return nil, err
+	}
+
+	return match, nil
+}
+
+// matchStatus returns the status of a match; matches created without a status are scheduled
+func matchStatus(match *entities.Match) entities.MatchStatus {
+	if match.Status == "" {
+		return entities.MatchStatusScheduled
+	}
+	return entities.MatchStatus(match.Status)
+}
+
+// checkMatchTransition reports an error when the match cannot move from its current status to the next one
+func checkMatchTransition(match *entities.Match, next entities.MatchStatus) error {
+	from := matchStatus(match)
+	if from == next || from.CanTransitionTo(next) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: cannot move match from %s to %s", ErrInvalidMatchTransition, from, next)
+}
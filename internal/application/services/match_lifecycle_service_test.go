@@ -0,0 +1,205 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newTestMatchLifecycleService creates a lifecycle service around a single scheduled match
+func newTestMatchLifecycleService(t *testing.T) (*MatchLifecycleService, *MatchService, *MockMatchRepository, *entities.Match) {
+	t.Helper()
+	repo := NewMockMatchRepository()
+	ruleSetService, _, _ := newTestRuleSetService()
+	matchService := NewMatchService(repo, ruleSetService)
+
+	match := &entities.Match{
+		HomeTeamID: 1,
+		AwayTeamID: 2,
+		SeasonID:   1,
+		StadiumID:  1,
+		Date:       time.Date(2025, 3, 1, 15, 0, 0, 0, time.UTC),
+		Stage:      entities.MatchStageRegular,
+		Status:     string(entities.MatchStatusScheduled),
+	}
+	if err := repo.Create(match); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	return NewMatchLifecycleService(repo, matchService), matchService, repo, match
+}
+
+// TestMatchLifecycleService_FullMatch tests the happy path from kick-off to the final whistle
+func TestMatchLifecycleService_FullMatch(t *testing.T) {
+	service, matchService, repo, match := newTestMatchLifecycleService(t)
+
+	finished := 0
+	matchService.OnMatchFinished(func(*entities.Match) error {
+		finished++
+		return nil
+	})
+	var updates []entities.MatchUpdateType
+	matchService.OnMatchUpdated(func(update entities.MatchUpdate) {
+		updates = append(updates, update.Type)
+	})
+
+	started, err := service.Start(match.ID)
+	if err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if started.KickedOffAt == nil || *started.HomeTeamScore != 0 || *started.AwayTeamScore != 0 {
+		t.Fatalf("kick-off should record the time and a 0-0 score")
+	}
+
+	if _, err := service.HalfTime(match.ID); err != nil {
+		t.Fatalf("HalfTime() error = %v", err)
+	}
+	if live, _ := repo.GetLive(); len(live) != 1 {
+		t.Errorf("a match at half time should still be live")
+	}
+
+	secondHalf, err := service.Start(match.ID)
+	if err != nil {
+		t.Fatalf("Start() after half time error = %v", err)
+	}
+	if secondHalf.SecondHalfStartedAt == nil || secondHalf.HalfTimeAt == nil {
+		t.Errorf("second half should record the half time and restart times")
+	}
+
+	if err := matchService.UpdateMatchScore(match.ID, 2, 1); err != nil {
+		t.Fatalf("UpdateMatchScore() error = %v", err)
+	}
+	ended, err := service.Finish(match.ID)
+	if err != nil {
+		t.Fatalf("Finish() error = %v", err)
+	}
+	if ended.FinishedAt == nil || ended.HomeTeamPoints == nil || *ended.HomeTeamPoints != 3 || *ended.AwayTeamPoints != 0 {
+		t.Errorf("finish should record the time and settle 3 and 0 points")
+	}
+	if finished != 1 {
+		t.Errorf("finished hooks ran %d times, want 1", finished)
+	}
+
+	wantUpdates := []entities.MatchUpdateType{
+		entities.MatchUpdateStatus, entities.MatchUpdateStatus, entities.MatchUpdateStatus,
+		entities.MatchUpdateScore, entities.MatchUpdateStatus, entities.MatchUpdateScore,
+	}
+	if !reflect.DeepEqual(updates, wantUpdates) {
+		t.Errorf("published updates = %v, want %v", updates, wantUpdates)
+	}
+}
+
+// TestMatchLifecycleService_IllegalTransitions tests that invalid status changes are rejected
+func TestMatchLifecycleService_IllegalTransitions(t *testing.T) {
+	service, matchService, repo, match := newTestMatchLifecycleService(t)
+
+	if _, err := service.HalfTime(match.ID); !errors.Is(err, ErrInvalidMatchTransition) {
+		t.Errorf("HalfTime() on a scheduled match error = %v, want ErrInvalidMatchTransition", err)
+	}
+	if _, err := service.Finish(match.ID); !errors.Is(err, ErrInvalidMatchTransition) {
+		t.Errorf("Finish() on a scheduled match error = %v, want ErrInvalidMatchTransition", err)
+	}
+
+	if _, err := service.Postpone(match.ID); err != nil {
+		t.Fatalf("Postpone() error = %v", err)
+	}
+	if _, err := service.Start(match.ID); !errors.Is(err, ErrInvalidMatchTransition) {
+		t.Errorf("Start() on a postponed match error = %v, want ErrInvalidMatchTransition", err)
+	}
+
+	newDate := time.Date(2025, 4, 1, 15, 0, 0, 0, time.UTC)
+	rescheduled, err := service.Reschedule(match.ID, newDate)
+	if err != nil {
+		t.Fatalf("Reschedule() error = %v", err)
+	}
+	if !rescheduled.Date.Equal(newDate) {
+		t.Errorf("rescheduled date = %v, want %v", rescheduled.Date, newDate)
+	}
+
+	if _, err := service.Start(match.ID); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if _, err := service.Start(match.ID); !errors.Is(err, ErrInvalidMatchTransition) {
+		t.Errorf("Start() on a match in progress error = %v, want ErrInvalidMatchTransition", err)
+	}
+	if _, err := service.Abandon(match.ID); err != nil {
+		t.Fatalf("Abandon() error = %v", err)
+	}
+
+	// An abandoned match is final, even through a plain update
+	abandoned, _ := repo.GetByID(match.ID)
+	abandoned.Status = string(entities.MatchStatusScheduled)
+	if err := matchService.UpdateMatch(abandoned); !errors.Is(err, ErrInvalidMatchTransition) {
+		t.Errorf("UpdateMatch() back to scheduled error = %v, want ErrInvalidMatchTransition", err)
+	}
+	if _, err := service.Cancel(match.ID); !errors.Is(err, ErrInvalidMatchTransition) {
+		t.Errorf("Cancel() on an abandoned match error = %v, want ErrInvalidMatchTransition", err)
+	}
+}
+
+// TestMatchLifecycleService_FinishWithoutScore tests that a match created in progress,
+// without a score, finishes 0-0 with its points settled and its hooks run
+func TestMatchLifecycleService_FinishWithoutScore(t *testing.T) {
+	service, matchService, repo, match := newTestMatchLifecycleService(t)
+
+	finished := 0
+	matchService.OnMatchFinished(func(*entities.Match) error {
+		finished++
+		return nil
+	})
+
+	live, _ := repo.GetByID(match.ID)
+	live.Status = string(entities.MatchStatusInProgress)
+	repo.Update(live)
+
+	ended, err := service.Finish(match.ID)
+	if err != nil {
+		t.Fatalf("Finish() error = %v", err)
+	}
+	if *ended.HomeTeamScore != 0 || *ended.AwayTeamScore != 0 || ended.HomeTeamPoints == nil || *ended.HomeTeamPoints != 1 {
+		t.Errorf("finished match = %+v, want a 0-0 draw with 1 point each", ended)
+	}
+	if finished != 1 {
+		t.Errorf("finished hooks ran %d times, want 1", finished)
+	}
+}
+
+// TestMatchLifecycleService_UpdateStatus tests that a plain update can neither change the
+// status of a match nor run the finished hooks again
+func TestMatchLifecycleService_UpdateStatus(t *testing.T) {
+	service, matchService, repo, match := newTestMatchLifecycleService(t)
+	finished := 0
+	matchService.OnMatchFinished(func(*entities.Match) error {
+		finished++
+		return nil
+	})
+	if _, err := service.Start(match.ID); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	for _, status := range []entities.MatchStatus{entities.MatchStatusHalfTime, entities.MatchStatusFinished} {
+		live, _ := repo.GetByID(match.ID)
+		live.Status = string(status)
+		if err := matchService.UpdateMatch(live); !errors.Is(err, ErrInvalidMatchTransition) {
+			t.Errorf("UpdateMatch() to %s error = %v, want ErrInvalidMatchTransition", status, err)
+		}
+	}
+	if stored, _ := repo.GetByID(match.ID); stored.Status != string(entities.MatchStatusInProgress) || stored.HalfTimeAt != nil {
+		t.Errorf("match = %+v, want it still in progress", stored)
+	}
+
+	if _, err := service.Finish(match.ID); err != nil {
+		t.Fatalf("Finish() error = %v", err)
+	}
+	ended, _ := repo.GetByID(match.ID)
+	hour := 18
+	ended.Hour = &hour
+	if err := matchService.UpdateMatch(ended); err != nil {
+		t.Fatalf("UpdateMatch() of the finished match error = %v", err)
+	}
+	if finished != 1 {
+		t.Errorf("finished hooks ran %d times, want 1", finished)
+	}
+}
@@ -0,0 +1,111 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Codes of the eligibility violations reported for match player statistics
+const (
+	ViolationPlayerNotRegistered = "player_not_registered"
+	ViolationTeamNotInMatch      = "team_not_in_match"
+	ViolationTeamNotInSeason     = "team_not_in_season"
+	ViolationPlayerSuspended     = "player_suspended"
+)
+
+// EligibilityViolation describes one reason why a player cannot have statistics in a match
+type EligibilityViolation struct {
+	Code    string `json:"code"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// EligibilityError lists every eligibility rule broken by a match player statistic
+type EligibilityError struct {
+	Violations []EligibilityViolation
+}
+
+// Error joins the messages of all violations
+func (e *EligibilityError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		messages = append(messages, violation.Message)
+	}
+	return "player is not eligible for this match: " + strings.Join(messages, "; ")
+}
+
+// Unwrap exposes ErrPlayerSuspended when a suspension is one of the violations
+func (e *EligibilityError) Unwrap() []error {
+	for _, violation := range e.Violations {
+		if violation.Code == ViolationPlayerSuspended {
+			return []error{ErrPlayerSuspended}
+		}
+	}
+	return nil
+}
+
+// checkEligibility verifies that the player is registered with the team, that the team plays
+// the match and is enrolled in its season, and that the player is not suspended
+func (s *MatchPlayerService) checkEligibility(matchPlayer *entities.MatchPlayer, match *entities.Match) error {
+	violations := make([]EligibilityViolation, 0)
+
+	registered, err := s.transferService.IsRegistered(matchPlayer.PlayerID, matchPlayer.TeamID, match.Date)
+	if err != nil {
+		return err
+	}
+	if !registered {
+		violations = append(violations, EligibilityViolation{
+			Code:    ViolationPlayerNotRegistered,
+			Field:   "player_id",
+			Message: fmt.Sprintf("player %d is not registered with team %d on the match date", matchPlayer.PlayerID, matchPlayer.TeamID),
+		})
+	}
+
+	if matchPlayer.TeamID != match.HomeTeamID && matchPlayer.TeamID != match.AwayTeamID {
+		violations = append(violations, EligibilityViolation{
+			Code:    ViolationTeamNotInMatch,
+			Field:   "team_id",
+			Message: fmt.Sprintf("team %d is neither the home nor the away side of match %d", matchPlayer.TeamID, match.ID),
+		})
+	}
+
+	season, err := s.seasonRepo.GetWithTeams(match.SeasonID)
+	if err != nil {
+		return err
+	}
+	if !seasonHasTeam(season, matchPlayer.TeamID) {
+		violations = append(violations, EligibilityViolation{
+			Code:    ViolationTeamNotInSeason,
+			Field:   "team_id",
+			Message: fmt.Sprintf("team %d is not enrolled in season %d", matchPlayer.TeamID, match.SeasonID),
+		})
+	}
+
+	if err := s.discipline.CheckEligibility(matchPlayer.PlayerID, match); err != nil {
+		if !errors.Is(err, ErrPlayerSuspended) {
+			return err
+		}
+		violations = append(violations, EligibilityViolation{
+			Code:    ViolationPlayerSuspended,
+			Field:   "player_id",
+			Message: err.Error(),
+		})
+	}
+
+	if len(violations) > 0 {
+		return &EligibilityError{Violations: violations}
+	}
+	return nil
+}
+
+// seasonHasTeam reports whether a team is enrolled in a season
+func seasonHasTeam(season *entities.Season, teamID uint) bool {
+	for _, team := range season.Teams {
+		if team.ID == teamID {
+			return true
+		}
+	}
+	return false
+}
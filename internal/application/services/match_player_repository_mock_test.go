@@ -0,0 +1,130 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"sort"
+)
+
+// MockMatchPlayerRepository is an in-memory implementation of MatchPlayerRepository for testing.
+// Season lookups go through the match repository the statistics belong to.
+type MockMatchPlayerRepository struct {
+	matchRepo    *MockMatchRepository
+	matchPlayers map[uint]*entities.MatchPlayer
+	nextID       uint
+}
+
+// NewMockMatchPlayerRepository creates a new mock match player repository
+func NewMockMatchPlayerRepository(matchRepo *MockMatchRepository) *MockMatchPlayerRepository {
+	return &MockMatchPlayerRepository{
+		matchRepo:    matchRepo,
+		matchPlayers: make(map[uint]*entities.MatchPlayer),
+		nextID:       1,
+	}
+}
+
+func (m *MockMatchPlayerRepository) Create(matchPlayer *entities.MatchPlayer) error {
+	matchPlayer.ID = m.nextID
+	stored := *matchPlayer
+	m.matchPlayers[matchPlayer.ID] = &stored
+	m.nextID++
+	return nil
+}
+
+func (m *MockMatchPlayerRepository) GetByID(id uint) (*entities.MatchPlayer, error) {
+	if matchPlayer, exists := m.matchPlayers[id]; exists {
+		found := *matchPlayer
+		return &found, nil
+	}
+	return nil, errors.New("record not found")
+}
+
+func (m *MockMatchPlayerRepository) GetAll() ([]entities.MatchPlayer, error) {
+	return m.filter(func(*entities.MatchPlayer) bool { return true }), nil
+}
+
+func (m *MockMatchPlayerRepository) Update(matchPlayer *entities.MatchPlayer) error {
+	if _, exists := m.matchPlayers[matchPlayer.ID]; !exists {
+		return errors.New("record not found")
+	}
+	stored := *matchPlayer
+	m.matchPlayers[matchPlayer.ID] = &stored
+	return nil
+}
+
+func (m *MockMatchPlayerRepository) Delete(id uint) error {
+	delete(m.matchPlayers, id)
+	return nil
+}
+
+func (m *MockMatchPlayerRepository) GetByMatchID(matchID uint) ([]entities.MatchPlayer, error) {
+	return m.filter(func(mp *entities.MatchPlayer) bool { return mp.MatchID == matchID }), nil
+}
+
+func (m *MockMatchPlayerRepository) GetByPlayerID(playerID uint) ([]entities.MatchPlayer, error) {
+	return m.filter(func(mp *entities.MatchPlayer) bool { return mp.PlayerID == playerID }), nil
+}
+
+func (m *MockMatchPlayerRepository) GetByTeamID(teamID uint) ([]entities.MatchPlayer, error) {
+	return m.filter(func(mp *entities.MatchPlayer) bool { return mp.TeamID == teamID }), nil
+}
+
+func (m *MockMatchPlayerRepository) GetPlayerStats(playerID uint, seasonID uint) ([]entities.MatchPlayer, error) {
+	return m.filter(func(mp *entities.MatchPlayer) bool {
+		return mp.PlayerID == playerID && m.inSeason(mp, seasonID) && !m.administrative(mp)
+	}), nil
+}
+
+func (m *MockMatchPlayerRepository) GetBySeasonID(seasonID uint) ([]entities.MatchPlayer, error) {
+	return m.filter(func(mp *entities.MatchPlayer) bool { return m.inSeason(mp, seasonID) }), nil
+}
+
+// inSeason reports whether the statistic belongs to a match of the season
+func (m *MockMatchPlayerRepository) inSeason(matchPlayer *entities.MatchPlayer, seasonID uint) bool {
+	match, err := m.matchRepo.GetByID(matchPlayer.MatchID)
+	return err == nil && match.SeasonID == seasonID
+}
+
+// administrative reports whether the statistic belongs to a match settled by an administrative result
+func (m *MockMatchPlayerRepository) administrative(matchPlayer *entities.MatchPlayer) bool {
+	match, err := m.matchRepo.GetByID(matchPlayer.MatchID)
+	return err == nil && match.IsAdministrative()
+}
+
+// filter returns copies of the stored statistics that satisfy keep, ordered by ID
+func (m *MockMatchPlayerRepository) filter(keep func(*entities.MatchPlayer) bool) []entities.MatchPlayer {
+	matchPlayers := make([]entities.MatchPlayer, 0, len(m.matchPlayers))
+	for _, matchPlayer := range m.matchPlayers {
+		if keep(matchPlayer) {
+			matchPlayers = append(matchPlayers, *matchPlayer)
+		}
+	}
+	sort.Slice(matchPlayers, func(i, j int) bool { return matchPlayers[i].ID < matchPlayers[j].ID })
+	return matchPlayers
+}
+
+// sync replaces the statistics of a match like the GORM match event repository does
+func (m *MockMatchPlayerRepository) sync(matchID uint, stats []entities.MatchPlayer) error {
+	existing := make(map[uint]*entities.MatchPlayer)
+	for _, matchPlayer := range m.matchPlayers {
+		if matchPlayer.MatchID == matchID {
+			existing[matchPlayer.PlayerID] = matchPlayer
+		}
+	}
+
+	for i := range stats {
+		if current, ok := existing[stats[i].PlayerID]; ok {
+			stats[i].ID = current.ID
+			delete(existing, stats[i].PlayerID)
+			stored := stats[i]
+			m.matchPlayers[current.ID] = &stored
+			continue
+		}
+		m.Create(&stats[i])
+	}
+
+	for _, current := range existing {
+		current.Goals, current.Assists, current.OwnGoals, current.YellowCard, current.RedCard = 0, 0, 0, 0, 0
+	}
+	return nil
+}
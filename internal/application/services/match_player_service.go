@@ -4,17 +4,53 @@ import (
 	"catalyst-players/internal/domain/entities"
 	"catalyst-players/internal/domain/repositories"
 	"errors"
+	"time"
 )
 
 // MatchPlayerService handles business logic for match player statistics operations
 type MatchPlayerService struct {
 	matchPlayerRepo repositories.MatchPlayerRepository
+	matchRepo       repositories.MatchRepository
+	seasonRepo      repositories.SeasonRepository
+	transferService *TransferService
+	discipline      *DisciplineService
+	updatedHooks    []MatchUpdatedHook
 }
 
 // NewMatchPlayerService creates a new match player service instance
-func NewMatchPlayerService(matchPlayerRepo repositories.MatchPlayerRepository) *MatchPlayerService {
+func NewMatchPlayerService(matchPlayerRepo repositories.MatchPlayerRepository, matchRepo repositories.MatchRepository, seasonRepo repositories.SeasonRepository, transferService *TransferService, discipline *DisciplineService) *MatchPlayerService {
 	return &MatchPlayerService{
 		matchPlayerRepo: matchPlayerRepo,
+		matchRepo:       matchRepo,
+		seasonRepo:      seasonRepo,
+		transferService: transferService,
+		discipline:      discipline,
+	}
+}
+
+// OnStatsUpdated registers a hook that runs whenever player statistics of a match are saved
+func (s *MatchPlayerService) OnStatsUpdated(hook MatchUpdatedHook) {
+	s.updatedHooks = append(s.updatedHooks, hook)
+}
+
+// notifyUpdated runs the registered hooks for saved player statistics
+func (s *MatchPlayerService) notifyUpdated(matchPlayer *entities.MatchPlayer) {
+	if len(s.updatedHooks) == 0 {
+		return
+	}
+
+	snapshot := *matchPlayer
+	update := entities.MatchUpdate{
+		Type:        entities.MatchUpdatePlayerStats,
+		MatchID:     matchPlayer.MatchID,
+		PlayerStats: &snapshot,
+		OccurredAt:  time.Now(),
+	}
+	if match, err := s.matchRepo.GetByID(matchPlayer.MatchID); err == nil {
+		update.SeasonID = match.SeasonID
+	}
+	for _, hook := range s.updatedHooks {
+		hook(update)
 	}
 }
 
@@ -36,7 +72,21 @@ func (s *MatchPlayerService) CreateMatchPlayer(matchPlayer *entities.MatchPlayer
 		return errors.New("statistics cannot be negative")
 	}
 	
-	return s.matchPlayerRepo.Create(matchPlayer)
+	match, err := s.matchRepo.GetByID(matchPlayer.MatchID)
+	if err != nil {
+		return err
+	}
+	
+	if err := s.checkEligibility(matchPlayer, match); err != nil {
+		return err
+	}
+	
+	if err := s.matchPlayerRepo.Create(matchPlayer); err != nil {
+		return err
+	}
+
+	s.notifyUpdated(matchPlayer)
+	return nil
 }
 
 // GetMatchPlayerByID retrieves a match player statistic by ID
@@ -93,7 +143,8 @@ func (s *MatchPlayerService) GetPlayerStats(playerID uint, seasonID uint) ([]ent
 	return s.matchPlayerRepo.GetPlayerStats(playerID, seasonID)
 }
 
-// UpdateMatchPlayer updates an existing match player statistic
+// UpdateMatchPlayer updates an existing match player statistic. Match, team and player left
+// out keep their current values, and the result is checked for eligibility as on creation.
 func (s *MatchPlayerService) UpdateMatchPlayer(matchPlayer *entities.MatchPlayer) error {
 	if matchPlayer.ID == 0 {
 		return errors.New("invalid match player ID")
@@ -103,7 +154,35 @@ func (s *MatchPlayerService) UpdateMatchPlayer(matchPlayer *entities.MatchPlayer
 		return errors.New("statistics cannot be negative")
 	}
 	
-	return s.matchPlayerRepo.Update(matchPlayer)
+	existing, err := s.matchPlayerRepo.GetByID(matchPlayer.ID)
+	if err != nil {
+		return err
+	}
+	if matchPlayer.MatchID == 0 {
+		matchPlayer.MatchID = existing.MatchID
+	}
+	if matchPlayer.TeamID == 0 {
+		matchPlayer.TeamID = existing.TeamID
+	}
+	if matchPlayer.PlayerID == 0 {
+		matchPlayer.PlayerID = existing.PlayerID
+	}
+	
+	match, err := s.matchRepo.GetByID(matchPlayer.MatchID)
+	if err != nil {
+		return err
+	}
+	
+	if err := s.checkEligibility(matchPlayer, match); err != nil {
+		return err
+	}
+	
+	if err := s.matchPlayerRepo.Update(matchPlayer); err != nil {
+		return err
+	}
+
+	s.notifyUpdated(matchPlayer)
+	return nil
 }
 
 // DeleteMatchPlayer deletes a match player statistic by ID
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestMatchPlayerService_CreateReportsEveryViolation tests that statistics for a player
+// outside the squads of a match are rejected with every broken rule listed
+func TestMatchPlayerService_CreateReportsEveryViolation(t *testing.T) {
+	f := newDisciplineFixture(t, nil)
+	match := f.playRound(1)
+
+	tests := []struct {
+		name        string
+		matchPlayer entities.MatchPlayer
+		want        []string
+	}{
+		{
+			name:        "player of the other side",
+			matchPlayer: entities.MatchPlayer{MatchID: match.ID, TeamID: 2, PlayerID: 7},
+			want:        []string{ViolationPlayerNotRegistered},
+		},
+		{
+			name:        "team of the season outside the match",
+			matchPlayer: entities.MatchPlayer{MatchID: match.ID, TeamID: 3, PlayerID: 7},
+			want:        []string{ViolationPlayerNotRegistered, ViolationTeamNotInMatch},
+		},
+		{
+			name:        "team outside the season",
+			matchPlayer: entities.MatchPlayer{MatchID: match.ID, TeamID: 5, PlayerID: 7},
+			want:        []string{ViolationPlayerNotRegistered, ViolationTeamNotInMatch, ViolationTeamNotInSeason},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matchPlayer := tt.matchPlayer
+			err := f.matchPlayerService.CreateMatchPlayer(&matchPlayer)
+
+			var eligibility *EligibilityError
+			if !errors.As(err, &eligibility) {
+				t.Fatalf("CreateMatchPlayer() error = %v, want an EligibilityError", err)
+			}
+			if len(eligibility.Violations) != len(tt.want) {
+				t.Fatalf("got violations %+v, want codes %v", eligibility.Violations, tt.want)
+			}
+			for i, code := range tt.want {
+				if eligibility.Violations[i].Code != code {
+					t.Errorf("violation %d = %q, want %q", i, eligibility.Violations[i].Code, code)
+				}
+			}
+		})
+	}
+
+	if err := f.matchPlayerService.CreateMatchPlayer(&entities.MatchPlayer{MatchID: match.ID, TeamID: 1, PlayerID: 7}); err != nil {
+		t.Errorf("CreateMatchPlayer() for an eligible player error = %v", err)
+	}
+}
+
+// TestMatchPlayerService_StatsBeforeTransfer tests that statistics of matches played before
+// a player's first transfer are still accepted for the team they left
+func TestMatchPlayerService_StatsBeforeTransfer(t *testing.T) {
+	f := newDisciplineFixture(t, nil)
+	before := f.playRound(1)
+	if err := f.transferService.CreateTransferWindow(1, &entities.TransferWindow{
+		OpensAt:  time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC),
+		ClosesAt: time.Date(2025, 3, 12, 0, 0, 0, 0, time.UTC),
+	}); err != nil {
+		t.Fatalf("CreateTransferWindow() error = %v", err)
+	}
+	if _, err := f.transferService.Transfer(7, 2, 1, time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), 0); err != nil {
+		t.Fatalf("Transfer() error = %v", err)
+	}
+	after := f.playRound(15)
+
+	if err := f.matchPlayerService.CreateMatchPlayer(&entities.MatchPlayer{MatchID: before.ID, TeamID: 1, PlayerID: 7}); err != nil {
+		t.Errorf("CreateMatchPlayer() for the team left error = %v", err)
+	}
+	if err := f.matchPlayerService.CreateMatchPlayer(&entities.MatchPlayer{MatchID: after.ID, TeamID: 2, PlayerID: 7}); err != nil {
+		t.Errorf("CreateMatchPlayer() for the new team error = %v", err)
+	}
+
+	var eligibility *EligibilityError
+	err := f.matchPlayerService.CreateMatchPlayer(&entities.MatchPlayer{MatchID: after.ID, TeamID: 1, PlayerID: 7})
+	if !errors.As(err, &eligibility) || eligibility.Violations[0].Code != ViolationPlayerNotRegistered {
+		t.Errorf("CreateMatchPlayer() for the team left after the transfer error = %v, want %s", err, ViolationPlayerNotRegistered)
+	}
+}
+
+// TestMatchPlayerService_UpdateChecksEligibility tests that updated statistics are checked for
+// eligibility like new ones, keeping the match, team and player left out of the update
+func TestMatchPlayerService_UpdateChecksEligibility(t *testing.T) {
+	f := newDisciplineFixture(t, nil)
+	f.book(t, f.playRound(1), 7, 0, 1)
+	next := f.playRound(2)
+	f.book(t, next, 8, 0, 0)
+	stats, _ := f.matchPlayerRepo.GetByMatchID(next.ID)
+
+	var eligibility *EligibilityError
+	moved := entities.MatchPlayer{ID: stats[0].ID, TeamID: 3}
+	if err := f.matchPlayerService.UpdateMatchPlayer(&moved); !errors.As(err, &eligibility) {
+		t.Errorf("UpdateMatchPlayer() to a team outside the match error = %v, want an EligibilityError", err)
+	}
+	suspended := entities.MatchPlayer{ID: stats[0].ID, PlayerID: 7}
+	if err := f.matchPlayerService.UpdateMatchPlayer(&suspended); !errors.Is(err, ErrPlayerSuspended) {
+		t.Errorf("UpdateMatchPlayer() to a suspended player error = %v, want ErrPlayerSuspended", err)
+	}
+
+	scored := entities.MatchPlayer{ID: stats[0].ID, Goals: 2}
+	if err := f.matchPlayerService.UpdateMatchPlayer(&scored); err != nil {
+		t.Fatalf("UpdateMatchPlayer() error = %v", err)
+	}
+	updated, _ := f.matchPlayerRepo.GetByID(stats[0].ID)
+	if updated.MatchID != next.ID || updated.TeamID != 1 || updated.PlayerID != 8 || updated.Goals != 2 {
+		t.Errorf("updated statistic = %+v, want 2 goals for player 8 of team 1 in the match", updated)
+	}
+}
@@ -0,0 +1,155 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"sort"
+	"time"
+)
+
+// MockMatchRepository is an in-memory implementation of MatchRepository for testing
+type MockMatchRepository struct {
+	matches map[uint]*entities.Match
+	nextID  uint
+}
+
+// NewMockMatchRepository creates a new mock match repository
+func NewMockMatchRepository() *MockMatchRepository {
+	return &MockMatchRepository{
+		matches: make(map[uint]*entities.Match),
+		nextID:  1,
+	}
+}
+
+func (m *MockMatchRepository) Create(match *entities.Match) error {
+	match.ID = m.nextID
+	stored := *match
+	m.matches[match.ID] = &stored
+	m.nextID++
+	return nil
+}
+
+func (m *MockMatchRepository) CreateBatch(matches []entities.Match) error {
+	for i := range matches {
+		if err := m.Create(&matches[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MockMatchRepository) GetByID(id uint) (*entities.Match, error) {
+	if match, exists := m.matches[id]; exists {
+		found := *match
+		return &found, nil
+	}
+	return nil, errors.New("record not found")
+}
+
+func (m *MockMatchRepository) GetAll() ([]entities.Match, error) {
+	return m.filter(func(*entities.Match) bool { return true }), nil
+}
+
+func (m *MockMatchRepository) Update(match *entities.Match) error {
+	if _, exists := m.matches[match.ID]; !exists {
+		return errors.New("record not found")
+	}
+	stored := *match
+	m.matches[match.ID] = &stored
+	return nil
+}
+
+func (m *MockMatchRepository) Delete(id uint) error {
+	delete(m.matches, id)
+	return nil
+}
+
+func (m *MockMatchRepository) GetWithDetails(id uint) (*entities.Match, error) {
+	return m.GetByID(id)
+}
+
+func (m *MockMatchRepository) GetBySeasonID(seasonID uint) ([]entities.Match, error) {
+	return m.filter(func(match *entities.Match) bool { return match.SeasonID == seasonID }), nil
+}
+
+func (m *MockMatchRepository) GetByStage(seasonID uint, stage entities.MatchStage) ([]entities.Match, error) {
+	return m.filter(func(match *entities.Match) bool {
+		return match.SeasonID == seasonID && match.Stage == stage
+	}), nil
+}
+
+func (m *MockMatchRepository) GetByDateRange(startDate, endDate time.Time) ([]entities.Match, error) {
+	return m.filter(func(match *entities.Match) bool {
+		return !match.Date.Before(startDate) && !match.Date.After(endDate)
+	}), nil
+}
+
+func (m *MockMatchRepository) GetByTeamID(teamID uint, limit int) ([]entities.Match, error) {
+	matches := m.filter(func(match *entities.Match) bool {
+		return match.HomeTeamID == teamID || match.AwayTeamID == teamID
+	})
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches, nil
+}
+
+func (m *MockMatchRepository) GetUpcoming(limit int) ([]entities.Match, error) {
+	matches := m.filter(func(match *entities.Match) bool { return match.Date.After(time.Now()) })
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches, nil
+}
+
+func (m *MockMatchRepository) GetLive() ([]entities.Match, error) {
+	return m.filter(func(match *entities.Match) bool {
+		return entities.MatchStatus(match.Status).IsLive()
+	}), nil
+}
+
+func (m *MockMatchRepository) GetCompleted(seasonID uint) ([]entities.Match, error) {
+	return m.filter(func(match *entities.Match) bool {
+		return match.SeasonID == seasonID && match.Status == string(entities.MatchStatusFinished)
+	}), nil
+}
+
+func (m *MockMatchRepository) GetFinished() ([]entities.Match, error) {
+	matches := m.filter(func(match *entities.Match) bool {
+		return match.Status == string(entities.MatchStatusFinished)
+	})
+	sortMatchesChronologically(matches)
+	return matches, nil
+}
+
+func (m *MockMatchRepository) SaveResult(match *entities.Match, replaceKicks bool) error {
+	stored, exists := m.matches[match.ID]
+	if !exists {
+		return errors.New("record not found")
+	}
+	if replaceKicks {
+		for i := range match.PenaltyKicks {
+			match.PenaltyKicks[i].ID = uint(i + 1)
+		}
+		stored.PenaltyKicks = append([]entities.PenaltyKick(nil), match.PenaltyKicks...)
+	}
+	// Like the GORM implementation, only the result columns are written
+	stored.Status, stored.FinishedAt = match.Status, match.FinishedAt
+	stored.HomeTeamScore, stored.AwayTeamScore = match.HomeTeamScore, match.AwayTeamScore
+	stored.HomeTeamPoints, stored.AwayTeamPoints = match.HomeTeamPoints, match.AwayTeamPoints
+	stored.HomeExtraTimeScore, stored.AwayExtraTimeScore = match.HomeExtraTimeScore, match.AwayExtraTimeScore
+	stored.HomePenaltyScore, stored.AwayPenaltyScore = match.HomePenaltyScore, match.AwayPenaltyScore
+	return nil
+}
+
+// filter returns copies of the stored matches that satisfy keep, ordered by ID
+func (m *MockMatchRepository) filter(keep func(*entities.Match) bool) []entities.Match {
+	matches := make([]entities.Match, 0, len(m.matches))
+	for _, match := range m.matches {
+		if keep(match) {
+			matches = append(matches, *match)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].ID < matches[j].ID })
+	return matches
+}
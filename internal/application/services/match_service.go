@@ -3,19 +3,80 @@ package services
 import (
 	"catalyst-players/internal/domain/entities"
 	"catalyst-players/internal/domain/repositories"
+	"catalyst-players/internal/infrastructure/logger"
 	"errors"
+	"fmt"
 	"time"
 )
 
+// MatchFinishedHook is called after a finished match has been saved; its errors are logged
+type MatchFinishedHook func(match *entities.Match) error
+
+// MatchUpdatedHook is called after a change to a match has been saved
+type MatchUpdatedHook func(update entities.MatchUpdate)
+
 // MatchService handles business logic for match operations
 type MatchService struct {
-	matchRepo repositories.MatchRepository
+	matchRepo      repositories.MatchRepository
+	ruleSetService *RuleSetService
+	finishedHooks  []MatchFinishedHook
+	updatedHooks   []MatchUpdatedHook
+	logger         logger.Logger
 }
 
 // NewMatchService creates a new match service instance
-func NewMatchService(matchRepo repositories.MatchRepository) *MatchService {
+func NewMatchService(matchRepo repositories.MatchRepository, ruleSetService *RuleSetService) *MatchService {
 	return &MatchService{
-		matchRepo: matchRepo,
+		matchRepo:      matchRepo,
+		ruleSetService: ruleSetService,
+		logger:         logger.NewLogger(),
+	}
+}
+
+// OnMatchFinished registers a hook that runs whenever a finished match is saved
+func (s *MatchService) OnMatchFinished(hook MatchFinishedHook) {
+	s.finishedHooks = append(s.finishedHooks, hook)
+}
+
+// notifyFinished runs the registered hooks if the match is finished. The match is already
+// saved, so every hook runs even when an earlier one fails and the failures are only logged.
+func (s *MatchService) notifyFinished(match *entities.Match) {
+	if match.Status != string(entities.MatchStatusFinished) {
+		return
+	}
+
+	var errs []error
+	for _, hook := range s.finishedHooks {
+		if err := hook(match); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		s.logger.Error("Finished match hooks failed for match %d: %v", match.ID, err)
+	}
+}
+
+// OnMatchUpdated registers a hook that runs whenever the score or the status of a match changes
+func (s *MatchService) OnMatchUpdated(hook MatchUpdatedHook) {
+	s.updatedHooks = append(s.updatedHooks, hook)
+}
+
+// notifyUpdated runs the registered update hooks for a saved match
+func (s *MatchService) notifyUpdated(updateType entities.MatchUpdateType, match *entities.Match) {
+	if len(s.updatedHooks) == 0 {
+		return
+	}
+
+	snapshot := *match
+	update := entities.MatchUpdate{
+		Type:       updateType,
+		MatchID:    match.ID,
+		SeasonID:   match.SeasonID,
+		Match:      &snapshot,
+		OccurredAt: time.Now(),
+	}
+	for _, hook := range s.updatedHooks {
+		hook(update)
 	}
 }
 
@@ -131,6 +192,11 @@ func (s *MatchService) GetUpcoming(limit int) ([]entities.Match, error) {
 	return s.matchRepo.GetUpcoming(limit)
 }
 
+// GetLive retrieves the matches currently being played
+func (s *MatchService) GetLive() ([]entities.Match, error) {
+	return s.matchRepo.GetLive()
+}
+
 // GetCompleted retrieves completed matches for a season
 func (s *MatchService) GetCompleted(seasonID uint) ([]entities.Match, error) {
 	if seasonID == 0 {
@@ -165,42 +231,178 @@ func (s *MatchService) UpdateMatch(match *entities.Match) error {
 		}
 	}
 
+	// Status changes record the phase timestamps and settle finished matches, so they only
+	// go through the lifecycle endpoints
+	existing, err := s.matchRepo.GetByID(match.ID)
+	if err != nil {
+		return err
+	}
+	if match.Status == "" {
+		match.Status = existing.Status
+	}
+	if matchStatus(match) != matchStatus(existing) {
+		return fmt.Errorf("%w: the status of a match is changed through the lifecycle endpoints", ErrInvalidMatchTransition)
+	}
+
 	return s.matchRepo.Update(match)
 }
 
-// UpdateMatchScore updates the score of a match
+// MatchResult holds the regulation score of a match and, for knockout matches that end
+// level, the extra time goals and the penalty shootout in the order the kicks were taken.
+// Nil extra time scores and penalty kicks keep the ones already recorded.
+type MatchResult struct {
+	HomeScore          int                    `json:"home_score"`
+	AwayScore          int                    `json:"away_score"`
+	HomeExtraTimeScore *int                   `json:"home_extra_time_score"`
+	AwayExtraTimeScore *int                   `json:"away_extra_time_score"`
+	PenaltyKicks       []entities.PenaltyKick `json:"penalty_kicks"`
+}
+
+// UpdateMatchScore updates the regulation score of a match
 func (s *MatchService) UpdateMatchScore(matchID uint, homeScore, awayScore int) error {
+	return s.UpdateMatchResult(matchID, MatchResult{HomeScore: homeScore, AwayScore: awayScore})
+}
+
+// UpdateMatchResult updates the score of a match together with its extra time and penalty
+// shootout. The winner of a knockout match is decided by the regulation score, then the
+// extra time goals, then the shootout; league points only depend on the regulation score.
+// Matches settled with an administrative result are rejected with ErrAdministrativeResult.
+func (s *MatchService) UpdateMatchResult(matchID uint, result MatchResult) error {
 	match, err := s.matchRepo.GetByID(matchID)
 	if err != nil {
 		return err
 	}
 
+	if match.IsAdministrative() {
+		return ErrAdministrativeResult
+	}
+
+	homeScore, awayScore := result.HomeScore, result.AwayScore
 	if homeScore < 0 || awayScore < 0 {
 		return errors.New("scores cannot be negative")
 	}
 
 	match.HomeTeamScore = &homeScore
 	match.AwayTeamScore = &awayScore
+	if result.HomeExtraTimeScore != nil || result.AwayExtraTimeScore != nil {
+		match.HomeExtraTimeScore = result.HomeExtraTimeScore
+		match.AwayExtraTimeScore = result.AwayExtraTimeScore
+	}
+	if result.PenaltyKicks != nil {
+		// A new shootout replaces the recorded kicks instead of updating them
+		match.PenaltyKicks = make([]entities.PenaltyKick, 0, len(result.PenaltyKicks))
+		for _, kick := range result.PenaltyKicks {
+			kick.ID = 0
+			match.PenaltyKicks = append(match.PenaltyKicks, kick)
+		}
+	}
+
+	if err := validateKnockoutDeciders(match); err != nil {
+		return err
+	}
 
-	// Calculate points based on result
-	if homeScore > awayScore {
-		homePoints := 3
-		awayPoints := 0
-		match.HomeTeamPoints = &homePoints
-		match.AwayTeamPoints = &awayPoints
-	} else if homeScore < awayScore {
-		homePoints := 0
-		awayPoints := 3
-		match.HomeTeamPoints = &homePoints
-		match.AwayTeamPoints = &awayPoints
-	} else {
-		homePoints := 1
-		awayPoints := 1
-		match.HomeTeamPoints = &homePoints
-		match.AwayTeamPoints = &awayPoints
+	if err := s.settle(match, result.PenaltyKicks != nil); err != nil {
+		return err
 	}
 
-	return s.matchRepo.Update(match)
+	s.notifyUpdated(entities.MatchUpdateScore, match)
+
+	s.notifyFinished(match)
+	return nil
+}
+
+// settle calculates the points of a match from its regulation score using the season
+// rules and saves the result, with the penalty kicks when replaceKicks is set; the
+// caller runs the hooks
+func (s *MatchService) settle(match *entities.Match, replaceKicks bool) error {
+	if err := s.awardPoints(match); err != nil {
+		return err
+	}
+
+	return s.matchRepo.SaveResult(match, replaceKicks)
+}
+
+// awardPoints calculates the points of a match from its regulation score using the season rules
+func (s *MatchService) awardPoints(match *entities.Match) error {
+	rules, err := s.ruleSetService.ResolveForSeason(match.SeasonID)
+	if err != nil {
+		return err
+	}
+
+	homePoints := rules.Points(*match.HomeTeamScore, *match.AwayTeamScore)
+	awayPoints := rules.Points(*match.AwayTeamScore, *match.HomeTeamScore)
+	match.HomeTeamPoints = &homePoints
+	match.AwayTeamPoints = &awayPoints
+	return nil
+}
+
+// validateKnockoutDeciders checks the extra time and the penalty shootout of a knockout
+// match, numbers the kicks in the order they were taken and counts the shootout score.
+// The score of the match itself may differ: the second leg of a tie goes to extra time
+// whenever the aggregate score is level.
+func validateKnockoutDeciders(match *entities.Match) error {
+	hasExtraTime := match.HomeExtraTimeScore != nil || match.AwayExtraTimeScore != nil
+	hasShootout := len(match.PenaltyKicks) > 0
+	if !hasExtraTime && !hasShootout {
+		match.HomePenaltyScore, match.AwayPenaltyScore = nil, nil
+		return nil
+	}
+
+	if match.Stage == "" || match.Stage == entities.MatchStageRegular {
+		return errors.New("only knockout matches have extra time and penalty shootouts")
+	}
+
+	if hasExtraTime {
+		if match.HomeExtraTimeScore == nil || match.AwayExtraTimeScore == nil {
+			return errors.New("both extra time scores are required")
+		}
+		if *match.HomeExtraTimeScore < 0 || *match.AwayExtraTimeScore < 0 {
+			return errors.New("scores cannot be negative")
+		}
+	}
+
+	if !hasShootout {
+		match.HomePenaltyScore, match.AwayPenaltyScore = nil, nil
+		return nil
+	}
+
+	homePenalties, awayPenalties := 0, 0
+	homeKicks, awayKicks := 0, 0
+	for i := range match.PenaltyKicks {
+		kick := &match.PenaltyKicks[i]
+		kick.MatchID = match.ID
+		kick.Order = i + 1
+		if kick.PlayerID == 0 {
+			return fmt.Errorf("penalty kick %d has no player", kick.Order)
+		}
+
+		switch kick.TeamID {
+		case match.HomeTeamID:
+			homeKicks++
+			if kick.Scored {
+				homePenalties++
+			}
+		case match.AwayTeamID:
+			awayKicks++
+			if kick.Scored {
+				awayPenalties++
+			}
+		default:
+			return fmt.Errorf("penalty kick %d was taken by team %d, which does not play the match", kick.Order, kick.TeamID)
+		}
+
+		if homeKicks-awayKicks > 1 || awayKicks-homeKicks > 1 {
+			return errors.New("teams must take their penalty kicks in turns")
+		}
+	}
+
+	if homePenalties == awayPenalties {
+		return errors.New("penalty shootout must produce a winner")
+	}
+
+	match.HomePenaltyScore = &homePenalties
+	match.AwayPenaltyScore = &awayPenalties
+	return nil
 }
 
 // DeleteMatch deletes a match by ID
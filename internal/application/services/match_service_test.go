@@ -0,0 +1,162 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"testing"
+)
+
+// shootout builds penalty kicks taken in turns, starting with the home team
+func shootout(homeTeamID, awayTeamID uint, scored []bool) []entities.PenaltyKick {
+	kicks := make([]entities.PenaltyKick, 0, len(scored))
+	for i, goal := range scored {
+		teamID := homeTeamID
+		if i%2 == 1 {
+			teamID = awayTeamID
+		}
+		kicks = append(kicks, entities.PenaltyKick{TeamID: teamID, PlayerID: uint(100 + i), Scored: goal})
+	}
+	return kicks
+}
+
+// TestMatchService_UpdateMatchResult tests that a level knockout match is won in the
+// shootout while league points only reflect the regulation score
+func TestMatchService_UpdateMatchResult(t *testing.T) {
+	repo := NewMockMatchRepository()
+	ruleSetService, _, _ := newTestRuleSetService()
+	service := NewMatchService(repo, ruleSetService)
+
+	final := newFinishedMatch(1, 1, 2, 0, 0)
+	final.Stage = entities.MatchStageFinal
+	repo.Create(final)
+
+	oneGoal := 1
+	err := service.UpdateMatchResult(final.ID, MatchResult{
+		HomeScore:          1,
+		AwayScore:          1,
+		HomeExtraTimeScore: &oneGoal,
+		AwayExtraTimeScore: &oneGoal,
+		PenaltyKicks:       shootout(1, 2, []bool{true, true, true, false, false, true, true}),
+	})
+	if err != nil {
+		t.Fatalf("UpdateMatchResult() error = %v", err)
+	}
+
+	stored, _ := repo.GetByID(final.ID)
+	if *stored.HomePenaltyScore != 3 || *stored.AwayPenaltyScore != 2 {
+		t.Errorf("shootout = %d-%d, want 3-2", *stored.HomePenaltyScore, *stored.AwayPenaltyScore)
+	}
+	if *stored.HomeTeamPoints != 1 || *stored.AwayTeamPoints != 1 {
+		t.Errorf("points = %d-%d, want a draw", *stored.HomeTeamPoints, *stored.AwayTeamPoints)
+	}
+	for i, kick := range stored.PenaltyKicks {
+		if kick.Order != i+1 || kick.MatchID != final.ID {
+			t.Errorf("kick %d stored with order %d for match %d", i+1, kick.Order, kick.MatchID)
+		}
+	}
+	if winner, _, ok := matchWinner(stored); !ok || winner != 1 {
+		t.Errorf("matchWinner() = %d, %v, want 1", winner, ok)
+	}
+
+	// A later score correction keeps the recorded extra time and shootout
+	if err := service.UpdateMatchScore(final.ID, 1, 1); err != nil {
+		t.Fatalf("UpdateMatchScore() error = %v", err)
+	}
+	stored, _ = repo.GetByID(final.ID)
+	if winner, _, ok := matchWinner(stored); !ok || winner != 1 || len(stored.PenaltyKicks) != 7 {
+		t.Errorf("after a score correction matchWinner() = %d, %v with %d kicks, want 1 with 7 kicks", winner, ok, len(stored.PenaltyKicks))
+	}
+}
+
+// TestMatchService_FinishedHooksAllRun tests that a failing hook neither stops the hooks
+// after it nor fails the saved score update
+func TestMatchService_FinishedHooksAllRun(t *testing.T) {
+	repo := NewMockMatchRepository()
+	ruleSetService, _, _ := newTestRuleSetService()
+	service := NewMatchService(repo, ruleSetService)
+
+	ran := 0
+	service.OnMatchFinished(func(*entities.Match) error {
+		ran++
+		return errors.New("bracket slot not found")
+	})
+	service.OnMatchFinished(func(*entities.Match) error {
+		ran++
+		return nil
+	})
+
+	match := newFinishedMatch(1, 1, 2, 0, 0)
+	repo.Create(match)
+	if err := service.UpdateMatchScore(match.ID, 2, 1); err != nil {
+		t.Fatalf("UpdateMatchScore() error = %v, want the saved result to succeed", err)
+	}
+	if ran != 2 {
+		t.Errorf("%d hooks ran, want 2", ran)
+	}
+	if stored, _ := repo.GetByID(match.ID); *stored.HomeTeamScore != 2 {
+		t.Errorf("home score = %d, want 2", *stored.HomeTeamScore)
+	}
+}
+
+// TestMatchService_RemoveShootout tests that an empty list of penalty kicks removes a
+// recorded shootout together with its score
+func TestMatchService_RemoveShootout(t *testing.T) {
+	repo := NewMockMatchRepository()
+	ruleSetService, _, _ := newTestRuleSetService()
+	service := NewMatchService(repo, ruleSetService)
+
+	semi := newFinishedMatch(1, 1, 2, 0, 0)
+	semi.Stage = entities.MatchStageSemis
+	repo.Create(semi)
+
+	if err := service.UpdateMatchResult(semi.ID, MatchResult{HomeScore: 1, AwayScore: 1, PenaltyKicks: shootout(1, 2, []bool{true, false})}); err != nil {
+		t.Fatalf("UpdateMatchResult() error = %v", err)
+	}
+	if err := service.UpdateMatchResult(semi.ID, MatchResult{HomeScore: 2, AwayScore: 1, PenaltyKicks: []entities.PenaltyKick{}}); err != nil {
+		t.Fatalf("UpdateMatchResult() removing the shootout error = %v", err)
+	}
+
+	stored, _ := repo.GetByID(semi.ID)
+	if stored.HomePenaltyScore != nil || stored.AwayPenaltyScore != nil || len(stored.PenaltyKicks) != 0 {
+		t.Errorf("shootout kept after removal: %v-%v with %d kicks", stored.HomePenaltyScore, stored.AwayPenaltyScore, len(stored.PenaltyKicks))
+	}
+	if winner, _, ok := matchWinner(stored); !ok || winner != 1 {
+		t.Errorf("matchWinner() = %d, %v, want 1", winner, ok)
+	}
+}
+
+// TestMatchService_UpdateMatchResultValidation tests the rejected extra time and shootouts
+func TestMatchService_UpdateMatchResultValidation(t *testing.T) {
+	repo := NewMockMatchRepository()
+	ruleSetService, _, _ := newTestRuleSetService()
+	service := NewMatchService(repo, ruleSetService)
+
+	league := newFinishedMatch(1, 1, 2, 0, 0)
+	repo.Create(league)
+	semi := newFinishedMatch(1, 1, 2, 0, 0)
+	semi.Stage = entities.MatchStageSemis
+	repo.Create(semi)
+
+	oneGoal := 1
+	outOfTurn := shootout(1, 2, []bool{true, false})
+	outOfTurn = append(outOfTurn, entities.PenaltyKick{TeamID: 2, PlayerID: 7}, entities.PenaltyKick{TeamID: 2, PlayerID: 8})
+	tests := []struct {
+		name    string
+		matchID uint
+		result  MatchResult
+	}{
+		{name: "shootout in a league match", matchID: league.ID, result: MatchResult{PenaltyKicks: shootout(1, 2, []bool{true, false})}},
+		{name: "extra time in a league match", matchID: league.ID, result: MatchResult{HomeExtraTimeScore: &oneGoal, AwayExtraTimeScore: &oneGoal}},
+		{name: "one extra time score", matchID: semi.ID, result: MatchResult{HomeExtraTimeScore: &oneGoal}},
+		{name: "level shootout", matchID: semi.ID, result: MatchResult{PenaltyKicks: shootout(1, 2, []bool{true, true})}},
+		{name: "kick by another team", matchID: semi.ID, result: MatchResult{PenaltyKicks: shootout(1, 3, []bool{true, false})}},
+		{name: "kicks out of turn", matchID: semi.ID, result: MatchResult{PenaltyKicks: outOfTurn}},
+		{name: "kick without a player", matchID: semi.ID, result: MatchResult{PenaltyKicks: []entities.PenaltyKick{{TeamID: 1, Scored: true}}}},
+	}
+
+	for _, tt := range tests {
+		if err := service.UpdateMatchResult(tt.matchID, tt.result); err == nil {
+			t.Errorf("UpdateMatchResult() with %s should fail", tt.name)
+		}
+	}
+}
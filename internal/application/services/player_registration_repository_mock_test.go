@@ -0,0 +1,138 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"sort"
+)
+
+// MockPlayerRegistrationRepository is an in-memory implementation of PlayerRegistrationRepository for testing
+type MockPlayerRegistrationRepository struct {
+	registrations map[uint]*entities.PlayerRegistration
+	nextID        uint
+	playerRepo    *MockPlayerRepository
+}
+
+// NewMockPlayerRegistrationRepository creates a new mock player registration repository that
+// moves transferred players in the given player repository
+func NewMockPlayerRegistrationRepository(playerRepo *MockPlayerRepository) *MockPlayerRegistrationRepository {
+	return &MockPlayerRegistrationRepository{
+		registrations: make(map[uint]*entities.PlayerRegistration),
+		nextID:        1,
+		playerRepo:    playerRepo,
+	}
+}
+
+// create stores a new registration
+func (m *MockPlayerRegistrationRepository) create(registration *entities.PlayerRegistration) error {
+	registration.ID = m.nextID
+	stored := *registration
+	m.registrations[registration.ID] = &stored
+	m.nextID++
+	return nil
+}
+
+// save overwrites a stored registration
+func (m *MockPlayerRegistrationRepository) save(registration *entities.PlayerRegistration) error {
+	if _, exists := m.registrations[registration.ID]; !exists {
+		return errors.New("record not found")
+	}
+	stored := *registration
+	m.registrations[registration.ID] = &stored
+	return nil
+}
+
+func (m *MockPlayerRegistrationRepository) Transfer(player *entities.Player, previous, registration *entities.PlayerRegistration) error {
+	if previous != nil {
+		if previous.ID == 0 {
+			m.create(previous)
+		} else if err := m.save(previous); err != nil {
+			return err
+		}
+	}
+	m.create(registration)
+	return m.playerRepo.Update(player)
+}
+
+func (m *MockPlayerRegistrationRepository) Register(player *entities.Player, registration *entities.PlayerRegistration) error {
+	if err := m.playerRepo.Create(player); err != nil {
+		return err
+	}
+	registration.PlayerID = player.ID
+	return m.create(registration)
+}
+
+func (m *MockPlayerRegistrationRepository) GetByPlayerID(playerID uint) ([]entities.PlayerRegistration, error) {
+	return m.filter(func(registration *entities.PlayerRegistration) bool {
+		return registration.PlayerID == playerID
+	}), nil
+}
+
+func (m *MockPlayerRegistrationRepository) GetBySeasonAndTeam(seasonID, teamID uint) ([]entities.PlayerRegistration, error) {
+	return m.filter(func(registration *entities.PlayerRegistration) bool {
+		return registration.SeasonID == seasonID && registration.TeamID == teamID
+	}), nil
+}
+
+// filter returns copies of the stored registrations that satisfy keep, oldest first
+func (m *MockPlayerRegistrationRepository) filter(keep func(*entities.PlayerRegistration) bool) []entities.PlayerRegistration {
+	registrations := make([]entities.PlayerRegistration, 0)
+	for _, registration := range m.registrations {
+		if keep(registration) {
+			registrations = append(registrations, *registration)
+		}
+	}
+	sort.Slice(registrations, func(i, j int) bool {
+		if !registrations[i].From.Equal(registrations[j].From) {
+			return registrations[i].From.Before(registrations[j].From)
+		}
+		return registrations[i].ID < registrations[j].ID
+	})
+	return registrations
+}
+
+// MockTransferWindowRepository is an in-memory implementation of TransferWindowRepository for testing
+type MockTransferWindowRepository struct {
+	windows map[uint]*entities.TransferWindow
+	nextID  uint
+}
+
+// NewMockTransferWindowRepository creates a new mock transfer window repository
+func NewMockTransferWindowRepository() *MockTransferWindowRepository {
+	return &MockTransferWindowRepository{
+		windows: make(map[uint]*entities.TransferWindow),
+		nextID:  1,
+	}
+}
+
+func (m *MockTransferWindowRepository) Create(window *entities.TransferWindow) error {
+	window.ID = m.nextID
+	stored := *window
+	m.windows[window.ID] = &stored
+	m.nextID++
+	return nil
+}
+
+func (m *MockTransferWindowRepository) GetByID(id uint) (*entities.TransferWindow, error) {
+	if window, exists := m.windows[id]; exists {
+		found := *window
+		return &found, nil
+	}
+	return nil, errors.New("record not found")
+}
+
+func (m *MockTransferWindowRepository) GetBySeasonID(seasonID uint) ([]entities.TransferWindow, error) {
+	windows := make([]entities.TransferWindow, 0)
+	for _, window := range m.windows {
+		if window.SeasonID == seasonID {
+			windows = append(windows, *window)
+		}
+	}
+	sort.Slice(windows, func(i, j int) bool { return windows[i].OpensAt.Before(windows[j].OpensAt) })
+	return windows, nil
+}
+
+func (m *MockTransferWindowRepository) Delete(id uint) error {
+	delete(m.windows, id)
+	return nil
+}
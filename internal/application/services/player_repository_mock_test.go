@@ -0,0 +1,87 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"sort"
+)
+
+// MockPlayerRepository is an in-memory implementation of PlayerRepository for testing
+type MockPlayerRepository struct {
+	players map[uint]*entities.Player
+	nextID  uint
+}
+
+// NewMockPlayerRepository creates a new mock player repository
+func NewMockPlayerRepository() *MockPlayerRepository {
+	return &MockPlayerRepository{
+		players: make(map[uint]*entities.Player),
+		nextID:  1,
+	}
+}
+
+func (m *MockPlayerRepository) Create(player *entities.Player) error {
+	if player.ID == 0 {
+		player.ID = m.nextID
+	}
+	if player.ID >= m.nextID {
+		m.nextID = player.ID + 1
+	}
+	stored := *player
+	m.players[player.ID] = &stored
+	return nil
+}
+
+func (m *MockPlayerRepository) GetByID(id uint) (*entities.Player, error) {
+	if player, exists := m.players[id]; exists {
+		found := *player
+		return &found, nil
+	}
+	return nil, errors.New("record not found")
+}
+
+func (m *MockPlayerRepository) GetAll() ([]entities.Player, error) {
+	return m.filter(func(*entities.Player) bool { return true }), nil
+}
+
+func (m *MockPlayerRepository) Update(player *entities.Player) error {
+	if _, exists := m.players[player.ID]; !exists {
+		return errors.New("record not found")
+	}
+	stored := *player
+	m.players[player.ID] = &stored
+	return nil
+}
+
+func (m *MockPlayerRepository) Delete(id uint) error {
+	delete(m.players, id)
+	return nil
+}
+
+func (m *MockPlayerRepository) GetByTeamID(teamID uint) ([]entities.Player, error) {
+	return m.filter(func(player *entities.Player) bool { return player.TeamID == teamID }), nil
+}
+
+func (m *MockPlayerRepository) GetWithTeam(id uint) (*entities.Player, error) {
+	return m.GetByID(id)
+}
+
+func (m *MockPlayerRepository) GetWithTags(id uint) (*entities.Player, error) {
+	return m.GetByID(id)
+}
+
+func (m *MockPlayerRepository) GetTopScorers(seasonID uint, limit int) ([]entities.Player, error) {
+	return nil, errors.New("not supported by the mock")
+}
+
+// filter returns copies of the stored players that satisfy keep, ordered by ID
+func (m *MockPlayerRepository) filter(keep func(*entities.Player) bool) []entities.Player {
+	players := make([]entities.Player, 0, len(m.players))
+	for _, player := range m.players {
+		if keep(player) {
+			players = append(players, *player)
+		}
+	}
+	sort.Slice(players, func(i, j int) bool { return players[i].ID < players[j].ID })
+	return players
+}
@@ -7,20 +7,26 @@ import (
 	"time"
 )
 
+// ErrPlayerTeamChange is returned when a player update changes the team of the player
+var ErrPlayerTeamChange = errors.New("the team of a player is changed through POST /players/:id/transfer")
+
 // PlayerService handles business logic for player operations
 type PlayerService struct {
-	playerRepo repositories.PlayerRepository
+	playerRepo      repositories.PlayerRepository
+	transferService *TransferService
 }
 
 // NewPlayerService creates a new player service instance
-func NewPlayerService(playerRepo repositories.PlayerRepository) *PlayerService {
+func NewPlayerService(playerRepo repositories.PlayerRepository, transferService *TransferService) *PlayerService {
 	return &PlayerService{
-		playerRepo: playerRepo,
+		playerRepo:      playerRepo,
+		transferService: transferService,
 	}
 }
 
-// CreatePlayer creates a new player
-func (s *PlayerService) CreatePlayer(player *entities.Player) error {
+// CreatePlayer creates a new player registered with their team for a season from the given
+// moment, which must fall within a transfer window of the season; a zero moment means now
+func (s *PlayerService) CreatePlayer(player *entities.Player, seasonID uint, at time.Time) error {
 	if player.Name == "" {
 		return errors.New("player name is required")
 	}
@@ -42,7 +48,8 @@ func (s *PlayerService) CreatePlayer(player *entities.Player) error {
 		return errors.New("player must be at least 5 years old")
 	}
 	
-	return s.playerRepo.Create(player)
+	_, err := s.transferService.Register(player, seasonID, at)
+	return err
 }
 
 // GetPlayerByID retrieves a player by ID
@@ -77,7 +84,7 @@ func (s *PlayerService) GetPlayersByTeamID(teamID uint) ([]entities.Player, erro
 	return s.playerRepo.GetByTeamID(teamID)
 }
 
-// UpdatePlayer updates an existing player
+// UpdatePlayer updates an existing player. The team of a player only changes through a transfer.
 func (s *PlayerService) UpdatePlayer(player *entities.Player) error {
 	if player.ID == 0 {
 		return errors.New("invalid player ID")
@@ -91,6 +98,15 @@ func (s *PlayerService) UpdatePlayer(player *entities.Player) error {
 		return errors.New("player last name is required")
 	}
 	
+	existing, err := s.playerRepo.GetByID(player.ID)
+	if err != nil {
+		return err
+	}
+	
+	if player.TeamID != 0 && player.TeamID != existing.TeamID {
+		return ErrPlayerTeamChange
+	}
+	
 	return s.playerRepo.Update(player)
 }
 
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestPlayerService_CreateRegistersPlayer tests that a new player gets their first registration
+// and that the transfer window and shirt numbers are checked as for a transfer
+func TestPlayerService_CreateRegistersPlayer(t *testing.T) {
+	transferService, playerRepo := newTestTransferService(t)
+	service := NewPlayerService(playerRepo, transferService)
+	birthDate := time.Date(2000, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	signed := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	player := &entities.Player{Name: "Grace", LastName: "Keeper", BirthDate: birthDate, TeamID: 2, Number: 1}
+	if err := service.CreatePlayer(player, 1, signed); err != nil {
+		t.Fatalf("CreatePlayer() error = %v", err)
+	}
+
+	career, err := transferService.GetCareer(player.ID)
+	if err != nil {
+		t.Fatalf("GetCareer() error = %v", err)
+	}
+	if len(career) != 1 || career[0].TeamID != 2 || career[0].SeasonID != 1 || career[0].ShirtNumber != 1 || !career[0].From.Equal(signed) || career[0].To != nil {
+		t.Errorf("career = %+v, want an open registration at team 2 with number 1 from the signing", career)
+	}
+
+	late := &entities.Player{Name: "Late", LastName: "Signing", BirthDate: birthDate, TeamID: 2, Number: 4}
+	if err := service.CreatePlayer(late, 1, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)); !errors.Is(err, ErrTransferWindowClosed) {
+		t.Errorf("CreatePlayer() outside the window error = %v, want ErrTransferWindowClosed", err)
+	}
+	taken := &entities.Player{Name: "Second", LastName: "Keeper", BirthDate: birthDate, TeamID: 2, Number: 1}
+	if err := service.CreatePlayer(taken, 1, signed); err == nil {
+		t.Errorf("CreatePlayer() with a shirt number already taken should fail")
+	}
+	if players, _ := playerRepo.GetByTeamID(2); len(players) != 1 {
+		t.Errorf("team 2 has %d players, want only the registered one", len(players))
+	}
+}
+
+// TestPlayerService_UpdateKeepsTeam tests that a player update cannot move the player to another team
+func TestPlayerService_UpdateKeepsTeam(t *testing.T) {
+	transferService, playerRepo := newTestTransferService(t)
+	service := NewPlayerService(playerRepo, transferService)
+
+	moved := &entities.Player{ID: 1, Name: "Ada", LastName: "Striker", TeamID: 2, Number: 9}
+	if err := service.UpdatePlayer(moved); !errors.Is(err, ErrPlayerTeamChange) {
+		t.Errorf("UpdatePlayer() to another team error = %v, want ErrPlayerTeamChange", err)
+	}
+
+	renamed := &entities.Player{ID: 1, Name: "Ada", LastName: "Forward", TeamID: 1, Number: 9}
+	if err := service.UpdatePlayer(renamed); err != nil {
+		t.Fatalf("UpdatePlayer() error = %v", err)
+	}
+	player, _ := playerRepo.GetByID(1)
+	if player.TeamID != 1 || player.LastName != "Forward" {
+		t.Errorf("player = %+v, want Ada Forward at team 1", player)
+	}
+}
@@ -0,0 +1,421 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// playoffRoundInterval is the gap between a knockout round and the next one
+const playoffRoundInterval = 7 * 24 * time.Hour
+
+// knockoutStages lists the knockout stages from the earliest to the final
+var knockoutStages = []entities.MatchStage{
+	entities.MatchStageQuarters,
+	entities.MatchStageSemis,
+	entities.MatchStageFinal,
+}
+
+// PlayoffOptions holds the parameters used to create a knockout bracket. For seasons with
+// a group stage, QualifiersPerGroup teams of every group qualify; it defaults to Teams
+// divided by the number of groups.
+type PlayoffOptions struct {
+	Teams              int       `json:"teams"`
+	QualifiersPerGroup int       `json:"qualifiers_per_group"`
+	StadiumID          uint      `json:"stadium_id"`
+	Date               time.Time `json:"date"`
+}
+
+// PlayoffService handles knockout brackets seeded from the season standings
+type PlayoffService struct {
+	matchRepo          repositories.MatchRepository
+	leaderboardService *LeaderboardService
+	groupService       *GroupService
+	tieService         *TieService
+}
+
+// slotOutcome is the result of a bracket slot played as a single match or as a two-legged tie
+type slotOutcome struct {
+	winner, loser uint
+	// last is the match that settled the slot
+	last *entities.Match
+}
+
+// NewPlayoffService creates a new playoff service instance
+func NewPlayoffService(matchRepo repositories.MatchRepository, leaderboardService *LeaderboardService, groupService *GroupService, tieService *TieService) *PlayoffService {
+	return &PlayoffService{
+		matchRepo:          matchRepo,
+		leaderboardService: leaderboardService,
+		groupService:       groupService,
+		tieService:         tieService,
+	}
+}
+
+// CreatePlayoffs seeds the top teams of the season leaderboard, or the top teams of every
+// group when the season has a group stage, into the first knockout round
+func (s *PlayoffService) CreatePlayoffs(seasonID uint, opts PlayoffOptions) ([]entities.Match, error) {
+	if seasonID == 0 {
+		return nil, errors.New("invalid season ID")
+	}
+
+	groups, err := s.groupService.GetGroups(seasonID)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) > 0 {
+		if opts.QualifiersPerGroup == 0 {
+			if opts.Teams%len(groups) != 0 {
+				return nil, fmt.Errorf("%d playoff teams cannot be split evenly across %d groups", opts.Teams, len(groups))
+			}
+			opts.QualifiersPerGroup = opts.Teams / len(groups)
+		}
+		opts.Teams = opts.QualifiersPerGroup * len(groups)
+	}
+
+	firstStage, err := firstKnockoutStage(opts.Teams)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.StadiumID == 0 {
+		return nil, errors.New("stadium ID is required")
+	}
+
+	if opts.Date.IsZero() {
+		return nil, errors.New("playoff date is required")
+	}
+
+	for _, stage := range knockoutStages {
+		existing, err := s.matchRepo.GetByStage(seasonID, stage)
+		if err != nil {
+			return nil, err
+		}
+		if len(existing) > 0 {
+			return nil, errors.New("season already has playoff matches")
+		}
+	}
+
+	seeds, err := s.seeds(seasonID, len(groups) > 0, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	order := seedOrder(opts.Teams)
+	matches := make([]entities.Match, 0, opts.Teams/2)
+	for i := 0; i < len(order); i += 2 {
+		matches = append(matches, entities.Match{
+			HomeTeamID:  seeds[order[i]-1],
+			AwayTeamID:  seeds[order[i+1]-1],
+			SeasonID:    seasonID,
+			StadiumID:   opts.StadiumID,
+			Date:        opts.Date,
+			Stage:       firstStage,
+			Status:      string(entities.MatchStatusScheduled),
+			BracketSlot: i/2 + 1,
+		})
+	}
+
+	if err := s.matchRepo.CreateBatch(matches); err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+// seeds returns the playoff teams from the first seed to the last. Group winners are seeded
+// ahead of runners-up, so that the first round pairs them with runners-up of other groups.
+func (s *PlayoffService) seeds(seasonID uint, grouped bool, opts PlayoffOptions) ([]uint, error) {
+	if grouped {
+		return s.groupService.Qualifiers(seasonID, opts.QualifiersPerGroup)
+	}
+
+	leaderboard, err := s.leaderboardService.GenerateLeaderboard(seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(leaderboard) < opts.Teams {
+		return nil, fmt.Errorf("playoffs need %d ranked teams, season has %d", opts.Teams, len(leaderboard))
+	}
+
+	seeds := make([]uint, 0, opts.Teams)
+	for _, entry := range leaderboard[:opts.Teams] {
+		seeds = append(seeds, entry.TeamID)
+	}
+	return seeds, nil
+}
+
+// AdvanceBracket creates the next knockout match once the slot of a finished match and its
+// sibling slot both have a winner. A slot played over two legs is won by the winner of its
+// tie. Matches outside the bracket or without a winner yet are ignored.
+func (s *PlayoffService) AdvanceBracket(finished *entities.Match) error {
+	match, err := s.matchRepo.GetByID(finished.ID)
+	if err != nil {
+		return err
+	}
+
+	nextStage, ok := nextKnockoutStage(match.Stage)
+	if !ok || match.BracketSlot == 0 {
+		return nil
+	}
+
+	// The odd slot always feeds the home side of the next match
+	upperSlot := match.BracketSlot
+	if upperSlot%2 == 0 {
+		upperSlot--
+	}
+
+	stageMatches, err := s.matchRepo.GetByStage(match.SeasonID, match.Stage)
+	if err != nil {
+		return err
+	}
+
+	upper, err := s.slotOutcome(stageMatches, upperSlot)
+	if err != nil || upper == nil {
+		return err
+	}
+	lower, err := s.slotOutcome(stageMatches, upperSlot+1)
+	if err != nil || lower == nil {
+		return err
+	}
+
+	date := upper.last.Date
+	if lower.last.Date.After(date) {
+		date = lower.last.Date
+	}
+	date = date.Add(playoffRoundInterval)
+
+	nextSlot := (upperSlot + 1) / 2
+	if err := s.createIfMissing(entities.Match{
+		HomeTeamID:  upper.winner,
+		AwayTeamID:  lower.winner,
+		SeasonID:    match.SeasonID,
+		StadiumID:   upper.last.StadiumID,
+		Date:        date,
+		Stage:       nextStage,
+		Status:      string(entities.MatchStatusScheduled),
+		BracketSlot: nextSlot,
+	}); err != nil {
+		return err
+	}
+
+	if match.Stage != entities.MatchStageSemis {
+		return nil
+	}
+
+	return s.createIfMissing(entities.Match{
+		HomeTeamID:  upper.loser,
+		AwayTeamID:  lower.loser,
+		SeasonID:    match.SeasonID,
+		StadiumID:   upper.last.StadiumID,
+		Date:        date,
+		Stage:       entities.MatchStageThird,
+		Status:      string(entities.MatchStatusScheduled),
+		BracketSlot: 1,
+	})
+}
+
+// slotOutcome returns the winner of a bracket slot, or nil while it is undecided. A slot
+// holding two matches is decided by their tie.
+func (s *PlayoffService) slotOutcome(stageMatches []entities.Match, slot int) (*slotOutcome, error) {
+	var legs []*entities.Match
+	for i := range stageMatches {
+		if stageMatches[i].BracketSlot == slot {
+			legs = append(legs, &stageMatches[i])
+		}
+	}
+
+	switch len(legs) {
+	case 1:
+		if legs[0].Status != string(entities.MatchStatusFinished) {
+			return nil, nil
+		}
+		winner, loser, ok := matchWinner(legs[0])
+		if !ok {
+			return nil, nil
+		}
+		return &slotOutcome{winner: winner, loser: loser, last: legs[0]}, nil
+	case 2:
+		result, err := s.tieService.FindByMatch(legs[0].ID)
+		if err != nil || result == nil || !result.Decided {
+			return nil, err
+		}
+		return &slotOutcome{winner: result.WinnerID, loser: result.LoserID, last: &result.Tie.SecondLeg}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// GetBracket returns the knockout tree of a season, from the final down to the first round
+func (s *PlayoffService) GetBracket(seasonID uint) (*entities.Bracket, error) {
+	if seasonID == 0 {
+		return nil, errors.New("invalid season ID")
+	}
+
+	stages := []entities.MatchStage{entities.MatchStageThird}
+	stages = append(stages, knockoutStages...)
+
+	slots := make(map[entities.MatchStage]map[int]*entities.Match)
+	depth := 0
+	for _, stage := range stages {
+		matches, err := s.matchRepo.GetByStage(seasonID, stage)
+		if err != nil {
+			return nil, err
+		}
+
+		// A slot played over two legs shows its first leg
+		slots[stage] = make(map[int]*entities.Match)
+		for i := range matches {
+			existing := slots[stage][matches[i].BracketSlot]
+			if existing == nil || matches[i].Date.Before(existing.Date) {
+				slots[stage][matches[i].BracketSlot] = &matches[i]
+			}
+		}
+	}
+
+	// The earliest stage with matches tells how many rounds the bracket has
+	for i, stage := range knockoutStages {
+		if len(slots[stage]) > 0 {
+			depth = len(knockoutStages) - i
+			break
+		}
+	}
+
+	if depth == 0 {
+		return nil, errors.New("season has no playoff bracket")
+	}
+
+	ties, err := s.tieService.GetSeasonTies(seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	bracket := &entities.Bracket{
+		SeasonID: seasonID,
+		Final:    buildBracketNode(slots, len(knockoutStages)-1, 1, depth),
+	}
+	attachTies(&bracket.Final, ties)
+
+	if depth > 1 {
+		bracket.ThirdPlace = &entities.BracketNode{
+			Stage: entities.MatchStageThird,
+			Slot:  1,
+			Match: slots[entities.MatchStageThird][1],
+		}
+	}
+
+	return bracket, nil
+}
+
+// createIfMissing creates a bracket match unless its slot has already been filled
+func (s *PlayoffService) createIfMissing(match entities.Match) error {
+	existing, err := s.matchRepo.GetByStage(match.SeasonID, match.Stage)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range existing {
+		if m.BracketSlot == match.BracketSlot {
+			return nil
+		}
+	}
+
+	return s.matchRepo.Create(&match)
+}
+
+// buildBracketNode builds the node for a stage slot together with its feeder nodes
+func buildBracketNode(slots map[entities.MatchStage]map[int]*entities.Match, stageIndex, slot, depth int) entities.BracketNode {
+	stage := knockoutStages[stageIndex]
+	node := entities.BracketNode{
+		Stage: stage,
+		Slot:  slot,
+		Match: slots[stage][slot],
+	}
+
+	if len(knockoutStages)-stageIndex < depth {
+		node.Feeders = []entities.BracketNode{
+			buildBracketNode(slots, stageIndex-1, 2*slot-1, depth),
+			buildBracketNode(slots, stageIndex-1, 2*slot, depth),
+		}
+	}
+
+	return node
+}
+
+// attachTies adds the aggregate score of two-legged slots to a bracket node and its feeders
+func attachTies(node *entities.BracketNode, ties []entities.TieResult) {
+	for i := range ties {
+		if ties[i].Tie.Stage == node.Stage && ties[i].Tie.BracketSlot == node.Slot {
+			node.Tie = &ties[i]
+		}
+	}
+	for i := range node.Feeders {
+		attachTies(&node.Feeders[i], ties)
+	}
+}
+
+// firstKnockoutStage returns the opening stage for the given number of playoff teams
+func firstKnockoutStage(teams int) (entities.MatchStage, error) {
+	switch teams {
+	case 8:
+		return entities.MatchStageQuarters, nil
+	case 4:
+		return entities.MatchStageSemis, nil
+	case 2:
+		return entities.MatchStageFinal, nil
+	default:
+		return "", errors.New("playoff teams must be 2, 4 or 8")
+	}
+}
+
+// nextKnockoutStage returns the stage that follows the given one in the bracket
+func nextKnockoutStage(stage entities.MatchStage) (entities.MatchStage, bool) {
+	for i := 0; i < len(knockoutStages)-1; i++ {
+		if knockoutStages[i] == stage {
+			return knockoutStages[i+1], true
+		}
+	}
+	return "", false
+}
+
+// seedOrder returns the bracket position of every seed so that the top seeds
+// can only meet in the latest possible round, e.g. [1 8 4 5 2 7 3 6] for 8 teams
+func seedOrder(teams int) []int {
+	order := []int{1}
+	for size := 2; size <= teams; size *= 2 {
+		next := make([]int, 0, size)
+		for _, seed := range order {
+			next = append(next, seed, size+1-seed)
+		}
+		order = next
+	}
+	return order
+}
+
+// matchWinner returns the winning and losing team of a finished match. A level regulation
+// score is settled by the extra time goals and then by the penalty shootout.
+func matchWinner(match *entities.Match) (winner, loser uint, ok bool) {
+	if match.HomeTeamScore == nil || match.AwayTeamScore == nil {
+		return 0, 0, false
+	}
+
+	home, away := *match.HomeTeamScore, *match.AwayTeamScore
+	if home == away && match.HomeExtraTimeScore != nil && match.AwayExtraTimeScore != nil {
+		home += *match.HomeExtraTimeScore
+		away += *match.AwayExtraTimeScore
+	}
+	if home == away && match.HomePenaltyScore != nil && match.AwayPenaltyScore != nil {
+		home, away = *match.HomePenaltyScore, *match.AwayPenaltyScore
+	}
+
+	switch {
+	case home > away:
+		return match.HomeTeamID, match.AwayTeamID, true
+	case away > home:
+		return match.AwayTeamID, match.HomeTeamID, true
+	default:
+		return 0, 0, false
+	}
+}
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newFinishedMatch builds a finished regular stage match with its teams loaded
+func newFinishedMatch(seasonID, homeID, awayID uint, homeScore, awayScore int) *entities.Match {
+	return &entities.Match{
+		HomeTeamID:    homeID,
+		AwayTeamID:    awayID,
+		SeasonID:      seasonID,
+		StadiumID:     1,
+		Date:          time.Date(2025, 3, 1, 15, 0, 0, 0, time.UTC),
+		HomeTeamScore: &homeScore,
+		AwayTeamScore: &awayScore,
+		Stage:         entities.MatchStageRegular,
+		Status:        string(entities.MatchStatusFinished),
+		HomeTeam:      entities.Team{ID: homeID, Name: teamName(homeID)},
+		AwayTeam:      entities.Team{ID: awayID, Name: teamName(awayID)},
+	}
+}
+
+// teamName returns a predictable team name for test team IDs
+func teamName(id uint) string {
+	return "Team " + string(rune('A'+id-1))
+}
+
+// finishMatch records a final score on a knockout match through the match service
+func finishMatch(t *testing.T, repo *MockMatchRepository, matchService *MatchService, match entities.Match, homeScore, awayScore int) {
+	t.Helper()
+	match.Status = string(entities.MatchStatusFinished)
+	if err := repo.Update(&match); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if err := matchService.UpdateMatchScore(match.ID, homeScore, awayScore); err != nil {
+		t.Fatalf("UpdateMatchScore() error = %v", err)
+	}
+}
+
+// TestSeedOrder tests that top seeds are kept apart until the latest round
+func TestSeedOrder(t *testing.T) {
+	tests := []struct {
+		teams int
+		want  []int
+	}{
+		{teams: 2, want: []int{1, 2}},
+		{teams: 4, want: []int{1, 4, 2, 3}},
+		{teams: 8, want: []int{1, 8, 4, 5, 2, 7, 3, 6}},
+	}
+
+	for _, tt := range tests {
+		if got := seedOrder(tt.teams); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("seedOrder(%d) = %v, want %v", tt.teams, got, tt.want)
+		}
+	}
+}
+
+// TestPlayoffService_Bracket tests seeding and automatic progression up to the final
+func TestPlayoffService_Bracket(t *testing.T) {
+	repo := NewMockMatchRepository()
+	ruleSetService, _, _ := newTestRuleSetService()
+	matchService := NewMatchService(repo, ruleSetService)
+	leaderboardService := NewLeaderboardService(repo, NewMockMatchPlayerRepository(repo), ruleSetService, NewMockTeamSanctionRepository())
+	groupService := NewGroupService(NewMockGroupRepository(), NewMockSeasonRepository(), repo, leaderboardService)
+	playoffService := NewPlayoffService(repo, leaderboardService, groupService, NewTieService(NewMockTieRepository(repo), repo))
+	matchService.OnMatchFinished(playoffService.AdvanceBracket)
+
+	// Lower team IDs beat higher ones, so the final table is ranked by ID
+	for home := uint(1); home <= 8; home++ {
+		for away := home + 1; away <= 8; away++ {
+			repo.Create(newFinishedMatch(1, home, away, 1, 0))
+		}
+	}
+
+	quarters, err := playoffService.CreatePlayoffs(1, PlayoffOptions{
+		Teams:     8,
+		StadiumID: 1,
+		Date:      time.Date(2025, 6, 1, 15, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("CreatePlayoffs() error = %v", err)
+	}
+
+	wantPairs := [][2]uint{{1, 8}, {4, 5}, {2, 7}, {3, 6}}
+	for i, match := range quarters {
+		if match.Stage != entities.MatchStageQuarters || match.BracketSlot != i+1 {
+			t.Errorf("quarter %d has stage %q slot %d", i+1, match.Stage, match.BracketSlot)
+		}
+		if got := [2]uint{match.HomeTeamID, match.AwayTeamID}; got != wantPairs[i] {
+			t.Errorf("quarter %d = %v, want %v", i+1, got, wantPairs[i])
+		}
+	}
+
+	if _, err := playoffService.CreatePlayoffs(1, PlayoffOptions{Teams: 8, StadiumID: 1, Date: time.Now()}); err == nil {
+		t.Errorf("CreatePlayoffs() should reject a season that already has playoffs")
+	}
+
+	// Upsets in slots 2 and 4 so that seeds 5 and 6 reach the semis
+	finishMatch(t, repo, matchService, quarters[0], 2, 0)
+	semis, _ := repo.GetByStage(1, entities.MatchStageSemis)
+	if len(semis) != 0 {
+		t.Fatalf("semi created before both feeder matches finished")
+	}
+	finishMatch(t, repo, matchService, quarters[1], 0, 1)
+	finishMatch(t, repo, matchService, quarters[2], 3, 1)
+	finishMatch(t, repo, matchService, quarters[3], 1, 2)
+
+	semis, _ = repo.GetByStage(1, entities.MatchStageSemis)
+	if len(semis) != 2 {
+		t.Fatalf("got %d semis, want 2", len(semis))
+	}
+	for _, semi := range semis {
+		var want [2]uint
+		if semi.BracketSlot == 1 {
+			want = [2]uint{1, 5}
+		} else {
+			want = [2]uint{2, 6}
+		}
+		if got := [2]uint{semi.HomeTeamID, semi.AwayTeamID}; got != want {
+			t.Errorf("semi %d = %v, want %v", semi.BracketSlot, got, want)
+		}
+	}
+
+	// A level semi has no winner yet, so nothing advances
+	finishMatch(t, repo, matchService, semis[0], 1, 1)
+	finishMatch(t, repo, matchService, semis[1], 0, 2)
+	finals, _ := repo.GetByStage(1, entities.MatchStageFinal)
+	if len(finals) != 0 {
+		t.Fatalf("final created while a semi has no winner")
+	}
+
+	finishMatch(t, repo, matchService, semis[0], 2, 1)
+	finals, _ = repo.GetByStage(1, entities.MatchStageFinal)
+	thirds, _ := repo.GetByStage(1, entities.MatchStageThird)
+	if len(finals) != 1 || len(thirds) != 1 {
+		t.Fatalf("got %d finals and %d third-place matches, want 1 and 1", len(finals), len(thirds))
+	}
+	if finals[0].HomeTeamID != 1 || finals[0].AwayTeamID != 6 {
+		t.Errorf("final = %d vs %d, want 1 vs 6", finals[0].HomeTeamID, finals[0].AwayTeamID)
+	}
+	if thirds[0].HomeTeamID != 5 || thirds[0].AwayTeamID != 2 {
+		t.Errorf("third place = %d vs %d, want 5 vs 2", thirds[0].HomeTeamID, thirds[0].AwayTeamID)
+	}
+
+	bracket, err := playoffService.GetBracket(1)
+	if err != nil {
+		t.Fatalf("GetBracket() error = %v", err)
+	}
+	if bracket.Final.Match == nil || len(bracket.Final.Feeders) != 2 {
+		t.Fatalf("final node should have a match and two feeders")
+	}
+	for _, semi := range bracket.Final.Feeders {
+		if semi.Stage != entities.MatchStageSemis || len(semi.Feeders) != 2 {
+			t.Errorf("semi node %d should have two quarter feeders", semi.Slot)
+		}
+	}
+	if bracket.ThirdPlace == nil || bracket.ThirdPlace.Match == nil {
+		t.Errorf("bracket should include the third-place match")
+	}
+}
@@ -0,0 +1,282 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"errors"
+	"math"
+	"sort"
+)
+
+const (
+	// predictionMaxGoals is the largest number of goals per team the outcome probabilities cover
+	predictionMaxGoals = 10
+	// predictionScorelineGoals is the largest number of goals per team in the listed scorelines
+	predictionScorelineGoals = 5
+	// strengthIterations bounds the number of passes made when fitting team strengths
+	strengthIterations = 500
+	// strengthTolerance is the largest change between passes at which the fit has converged
+	strengthTolerance = 1e-10
+	// strengthPriorGoals is the weight, in goals, of the prior that every team is average. It
+	// keeps the strengths of teams that have not scored or conceded yet above zero.
+	strengthPriorGoals = 2
+)
+
+// PredictionService predicts the outcome of matches with a Poisson model. The goals a team
+// scores follow a Poisson distribution whose mean is the product of the average goal rate,
+// its attack strength, the defence strength of the opponent and, at home, the home advantage.
+type PredictionService struct {
+	matchRepo repositories.MatchRepository
+}
+
+// NewPredictionService creates a new prediction service instance
+func NewPredictionService(matchRepo repositories.MatchRepository) *PredictionService {
+	return &PredictionService{
+		matchRepo: matchRepo,
+	}
+}
+
+// PredictMatch fits the strengths of the teams to the other completed matches of the season
+// and returns the expected goals and the outcome and scoreline probabilities of a match
+func (s *PredictionService) PredictMatch(matchID uint) (*entities.MatchPrediction, error) {
+	if matchID == 0 {
+		return nil, errors.New("invalid match ID")
+	}
+
+	match, err := s.matchRepo.GetByID(matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	completed, err := s.matchRepo.GetCompleted(match.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	played := make([]entities.Match, 0, len(completed))
+	for i := range completed {
+		if completed[i].ID != match.ID && rateable(&completed[i]) {
+			played = append(played, completed[i])
+		}
+	}
+	if len(played) == 0 {
+		return nil, errors.New("season has no completed matches to fit the model")
+	}
+
+	strengths := fitTeamStrengths(played)
+	homeGoals, awayGoals := strengths.expectedGoals(match.HomeTeamID, match.AwayTeamID)
+
+	prediction := &entities.MatchPrediction{
+		MatchID:           match.ID,
+		HomeTeamID:        match.HomeTeamID,
+		AwayTeamID:        match.AwayTeamID,
+		HomeAttack:        strengths.attackOf(match.HomeTeamID),
+		HomeDefence:       strengths.defenceOf(match.HomeTeamID),
+		AwayAttack:        strengths.attackOf(match.AwayTeamID),
+		AwayDefence:       strengths.defenceOf(match.AwayTeamID),
+		HomeExpectedGoals: homeGoals,
+		AwayExpectedGoals: awayGoals,
+		MatchesUsed:       len(played),
+	}
+	predictOutcome(prediction)
+
+	return prediction, nil
+}
+
+// teamStrengths are the fitted parameters of the Poisson model. Attack and defence strengths
+// average 1 across the teams, so a team scores more than average with an attack above 1
+// and concedes more than average with a defence above 1.
+type teamStrengths struct {
+	goalRate      float64
+	homeAdvantage float64
+	attack        map[uint]float64
+	defence       map[uint]float64
+}
+
+// fitTeamStrengths finds the strengths for the regulation scores of the matches by updating
+// each parameter in turn until they no longer change. Each update makes the goals the model
+// expects match the goals observed: a team's expected goals scored and conceded, shrunk
+// towards an average team, the home goals and all goals.
+func fitTeamStrengths(matches []entities.Match) *teamStrengths {
+	strengths := &teamStrengths{
+		goalRate:      1,
+		homeAdvantage: 1,
+		attack:        make(map[uint]float64),
+		defence:       make(map[uint]float64),
+	}
+
+	scored := make(map[uint]float64)
+	conceded := make(map[uint]float64)
+	homeGoals, totalGoals := 0.0, 0.0
+	for i := range matches {
+		home, away := float64(*matches[i].HomeTeamScore), float64(*matches[i].AwayTeamScore)
+		scored[matches[i].HomeTeamID] += home
+		scored[matches[i].AwayTeamID] += away
+		conceded[matches[i].HomeTeamID] += away
+		conceded[matches[i].AwayTeamID] += home
+		homeGoals += home
+		totalGoals += home + away
+		strengths.attack[matches[i].HomeTeamID], strengths.attack[matches[i].AwayTeamID] = 1, 1
+		strengths.defence[matches[i].HomeTeamID], strengths.defence[matches[i].AwayTeamID] = 1, 1
+	}
+
+	for iteration := 0; iteration < strengthIterations; iteration++ {
+		previous := strengths.parameters()
+
+		// Expected goals of each team per unit of its own attack and defence strength
+		attackExposure := make(map[uint]float64)
+		defenceExposure := make(map[uint]float64)
+		for i := range matches {
+			home, away := matches[i].HomeTeamID, matches[i].AwayTeamID
+			attackExposure[home] += strengths.goalRate * strengths.homeAdvantage * strengths.defence[away]
+			attackExposure[away] += strengths.goalRate * strengths.defence[home]
+			defenceExposure[home] += strengths.goalRate * strengths.attack[away]
+			defenceExposure[away] += strengths.goalRate * strengths.homeAdvantage * strengths.attack[home]
+		}
+		for teamID := range strengths.attack {
+			updateStrength(strengths.attack, teamID, scored[teamID], attackExposure[teamID])
+			updateStrength(strengths.defence, teamID, conceded[teamID], defenceExposure[teamID])
+		}
+		strengths.normalize()
+
+		homeExposure, awayExposure := 0.0, 0.0
+		for i := range matches {
+			homeExposure += strengths.attack[matches[i].HomeTeamID] * strengths.defence[matches[i].AwayTeamID]
+			awayExposure += strengths.attack[matches[i].AwayTeamID] * strengths.defence[matches[i].HomeTeamID]
+		}
+		if homeExposure > 0 && homeGoals > 0 && totalGoals > homeGoals {
+			strengths.homeAdvantage = (homeGoals / homeExposure) / ((totalGoals - homeGoals) / awayExposure)
+		}
+		if exposure := strengths.homeAdvantage*homeExposure + awayExposure; exposure > 0 {
+			strengths.goalRate = totalGoals / exposure
+		}
+
+		if converged(previous, strengths.parameters()) {
+			break
+		}
+	}
+
+	return strengths
+}
+
+// updateStrength sets a strength to the ratio of observed to expected goals, both with the
+// goals of the prior added. Without the prior, a team that never scored would get an attack
+// of zero and be certain never to score; with it, few matches leave a strength close to 1.
+func updateStrength(strength map[uint]float64, teamID uint, observed, exposure float64) {
+	strength[teamID] = (observed + strengthPriorGoals) / (exposure + strengthPriorGoals)
+}
+
+// normalize scales attack and defence strengths to average 1, moving the scale into the goal rate
+func (t *teamStrengths) normalize() {
+	for _, strength := range []map[uint]float64{t.attack, t.defence} {
+		mean := 0.0
+		for _, value := range strength {
+			mean += value
+		}
+		if len(strength) > 0 {
+			mean /= float64(len(strength))
+		}
+		if mean == 0 {
+			continue
+		}
+		for teamID := range strength {
+			strength[teamID] /= mean
+		}
+		t.goalRate *= mean
+	}
+}
+
+// parameters lists every fitted parameter in a fixed order
+func (t *teamStrengths) parameters() []float64 {
+	teamIDs := make([]uint, 0, len(t.attack))
+	for teamID := range t.attack {
+		teamIDs = append(teamIDs, teamID)
+	}
+	sort.Slice(teamIDs, func(i, j int) bool { return teamIDs[i] < teamIDs[j] })
+
+	parameters := []float64{t.goalRate, t.homeAdvantage}
+	for _, teamID := range teamIDs {
+		parameters = append(parameters, t.attack[teamID], t.defence[teamID])
+	}
+	return parameters
+}
+
+// attackOf returns the attack strength of a team, or the average for a team without matches
+func (t *teamStrengths) attackOf(teamID uint) float64 {
+	if attack, ok := t.attack[teamID]; ok {
+		return attack
+	}
+	return 1
+}
+
+// defenceOf returns the defence strength of a team, or the average for a team without matches
+func (t *teamStrengths) defenceOf(teamID uint) float64 {
+	if defence, ok := t.defence[teamID]; ok {
+		return defence
+	}
+	return 1
+}
+
+// expectedGoals returns the mean goals of the home and away team in a match between them
+func (t *teamStrengths) expectedGoals(homeTeamID, awayTeamID uint) (float64, float64) {
+	home := t.goalRate * t.homeAdvantage * t.attackOf(homeTeamID) * t.defenceOf(awayTeamID)
+	away := t.goalRate * t.attackOf(awayTeamID) * t.defenceOf(homeTeamID)
+	return home, away
+}
+
+// converged reports whether no parameter changed by more than the tolerance
+func converged(previous, current []float64) bool {
+	for i := range current {
+		if math.Abs(current[i]-previous[i]) > strengthTolerance {
+			return false
+		}
+	}
+	return true
+}
+
+// predictOutcome fills in the outcome and scoreline probabilities of a prediction from its
+// expected goals. Outcome probabilities are scaled to sum to 1 over the scores covered.
+func predictOutcome(prediction *entities.MatchPrediction) {
+	home := poissonDistribution(prediction.HomeExpectedGoals, predictionMaxGoals)
+	away := poissonDistribution(prediction.AwayExpectedGoals, predictionMaxGoals)
+
+	var homeWin, draw, awayWin float64
+	scorelines := make([]entities.ScorelineProbability, 0, (predictionScorelineGoals+1)*(predictionScorelineGoals+1))
+	for homeGoals, homeProbability := range home {
+		for awayGoals, awayProbability := range away {
+			probability := homeProbability * awayProbability
+			switch {
+			case homeGoals > awayGoals:
+				homeWin += probability
+			case homeGoals < awayGoals:
+				awayWin += probability
+			default:
+				draw += probability
+			}
+			if homeGoals <= predictionScorelineGoals && awayGoals <= predictionScorelineGoals {
+				scorelines = append(scorelines, entities.ScorelineProbability{HomeGoals: homeGoals, AwayGoals: awayGoals, Probability: probability})
+			}
+		}
+	}
+
+	if total := homeWin + draw + awayWin; total > 0 {
+		prediction.HomeWinProbability = homeWin / total
+		prediction.DrawProbability = draw / total
+		prediction.AwayWinProbability = awayWin / total
+	}
+
+	sort.SliceStable(scorelines, func(i, j int) bool {
+		return scorelines[i].Probability > scorelines[j].Probability
+	})
+	prediction.Scorelines = scorelines
+}
+
+// poissonDistribution returns the probabilities of 0 to maxGoals goals for a mean number of goals
+func poissonDistribution(mean float64, maxGoals int) []float64 {
+	probabilities := make([]float64, maxGoals+1)
+	probabilities[0] = math.Exp(-mean)
+	for goals := 1; goals <= maxGoals; goals++ {
+		probabilities[goals] = probabilities[goals-1] * mean / float64(goals)
+	}
+	return probabilities
+}
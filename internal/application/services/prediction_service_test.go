@@ -0,0 +1,193 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"math"
+	"testing"
+)
+
+// newScheduledMatch creates a match of a season that has not been played yet
+func newScheduledMatch(seasonID, homeID, awayID uint) *entities.Match {
+	match := newFinishedMatch(seasonID, homeID, awayID, 0, 0)
+	match.Status = string(entities.MatchStatusScheduled)
+	match.HomeTeamScore, match.AwayTeamScore = nil, nil
+	return match
+}
+
+// assertProbability fails the test when a value differs from the expected one by more than a rounding error
+func assertProbability(t *testing.T, name string, got, want float64) {
+	t.Helper()
+	if math.Abs(got-want) > 1e-4 {
+		t.Errorf("%s = %.5f, want %.5f", name, got, want)
+	}
+}
+
+// TestPoissonDistribution tests the goal probabilities against known values
+func TestPoissonDistribution(t *testing.T) {
+	probabilities := poissonDistribution(1.5, 4)
+
+	// e^-1.5 * 1.5^k / k!
+	for goals, want := range []float64{0.22313, 0.33470, 0.25102, 0.12551, 0.04707} {
+		assertProbability(t, "P(goals)", probabilities[goals], want)
+	}
+}
+
+// TestPredictionService_PredictMatch tests predictions against fixtures whose fitted model is known
+func TestPredictionService_PredictMatch(t *testing.T) {
+	tests := []struct {
+		name                   string
+		results                [][4]int
+		homeGoals, awayGoals   float64
+		homeWin, draw, awayWin float64
+	}{
+		{
+			// Every team is average and there is no home advantage
+			name:      "all draws",
+			results:   [][4]int{{1, 2, 1, 1}, {2, 3, 1, 1}, {3, 1, 1, 1}},
+			homeGoals: 1, awayGoals: 1,
+			homeWin: 0.34575, draw: 0.30851, awayWin: 0.34575,
+		},
+		{
+			// Equal teams where the home team always scores three times as many goals
+			name:      "home advantage",
+			results:   [][4]int{{1, 2, 3, 1}, {2, 1, 3, 1}},
+			homeGoals: 3, awayGoals: 1,
+			homeWin: 0.77495, draw: 0.13116, awayWin: 0.09389,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matchRepo := NewMockMatchRepository()
+			service := NewPredictionService(matchRepo)
+			for _, result := range tt.results {
+				matchRepo.Create(newFinishedMatch(1, uint(result[0]), uint(result[1]), result[2], result[3]))
+			}
+			match := newScheduledMatch(1, 1, 2)
+			matchRepo.Create(match)
+
+			prediction, err := service.PredictMatch(match.ID)
+			if err != nil {
+				t.Fatalf("PredictMatch() error = %v", err)
+			}
+
+			assertProbability(t, "home expected goals", prediction.HomeExpectedGoals, tt.homeGoals)
+			assertProbability(t, "away expected goals", prediction.AwayExpectedGoals, tt.awayGoals)
+			assertProbability(t, "home win", prediction.HomeWinProbability, tt.homeWin)
+			assertProbability(t, "draw", prediction.DrawProbability, tt.draw)
+			assertProbability(t, "away win", prediction.AwayWinProbability, tt.awayWin)
+			if prediction.MatchesUsed != len(tt.results) {
+				t.Errorf("prediction used %d matches, want %d", prediction.MatchesUsed, len(tt.results))
+			}
+			if len(prediction.Scorelines) != 36 || prediction.Scorelines[0].Probability < prediction.Scorelines[35].Probability {
+				t.Errorf("got %d scorelines, want the 36 scores up to 5-5 with the most likely first", len(prediction.Scorelines))
+			}
+		})
+	}
+}
+
+// TestPredictionService_FittedStrengths tests that the fitted model expects as many goals and
+// home goals as were scored, shrinks the teams towards average, and that the predicted match,
+// other seasons and administrative results are left out of the fit
+func TestPredictionService_FittedStrengths(t *testing.T) {
+	matchRepo := NewMockMatchRepository()
+	service := NewPredictionService(matchRepo)
+
+	results := [][4]int{
+		{1, 2, 3, 0}, {1, 3, 2, 1}, {1, 4, 4, 1}, {2, 3, 1, 1},
+		{2, 4, 2, 0}, {3, 4, 0, 2}, {2, 1, 1, 2}, {3, 1, 1, 1},
+	}
+	played := make([]entities.Match, 0, len(results))
+	for _, result := range results {
+		match := newFinishedMatch(1, uint(result[0]), uint(result[1]), result[2], result[3])
+		matchRepo.Create(match)
+		played = append(played, *match)
+	}
+	matchRepo.Create(newFinishedMatch(2, 4, 1, 9, 0))
+	walkover := newFinishedMatch(1, 4, 2, 3, 0)
+	walkover.AdministrativeResult = entities.AdministrativeWalkover
+	matchRepo.Create(walkover)
+
+	strengths := fitTeamStrengths(played)
+	scored := make(map[uint]float64)
+	homeGoals, totalGoals := 0.0, 0.0
+	for _, match := range played {
+		home, away := strengths.expectedGoals(match.HomeTeamID, match.AwayTeamID)
+		scored[match.HomeTeamID] += home - float64(*match.HomeTeamScore)
+		scored[match.AwayTeamID] += away - float64(*match.AwayTeamScore)
+		homeGoals += home - float64(*match.HomeTeamScore)
+		totalGoals += home + away - float64(*match.HomeTeamScore+*match.AwayTeamScore)
+	}
+	assertProbability(t, "expected minus actual home goals", homeGoals, 0)
+	assertProbability(t, "expected minus actual goals", totalGoals, 0)
+	// Team 1 scored the most, so the prior expects fewer goals from it than it scored
+	if scored[1] >= 0 {
+		t.Errorf("team 1 is expected to score %.2f goals more than it did, want fewer", scored[1])
+	}
+
+	prediction, err := service.PredictMatch(played[0].ID)
+	if err != nil {
+		t.Fatalf("PredictMatch() error = %v", err)
+	}
+	if prediction.MatchesUsed != len(results)-1 {
+		t.Errorf("prediction used %d matches, want %d", prediction.MatchesUsed, len(results)-1)
+	}
+
+	match := newScheduledMatch(1, 1, 4)
+	matchRepo.Create(match)
+	prediction, err = service.PredictMatch(match.ID)
+	if err != nil {
+		t.Fatalf("PredictMatch() error = %v", err)
+	}
+	if prediction.MatchesUsed != len(results) {
+		t.Errorf("prediction used %d matches, want %d", prediction.MatchesUsed, len(results))
+	}
+	// Team 1 scored the most and team 4 conceded the most
+	if prediction.HomeAttack <= 1 || prediction.AwayDefence <= 1 || prediction.HomeWinProbability <= prediction.AwayWinProbability {
+		t.Errorf("prediction = %+v, want team 1 favoured against team 4", prediction)
+	}
+	assertProbability(t, "outcome probabilities", prediction.HomeWinProbability+prediction.DrawProbability+prediction.AwayWinProbability, 1)
+}
+
+// TestPredictionService_GoallessTeam tests that a team that has not scored or conceded yet is
+// still expected to, so that no outcome is certain
+func TestPredictionService_GoallessTeam(t *testing.T) {
+	matchRepo := NewMockMatchRepository()
+	service := NewPredictionService(matchRepo)
+	for _, result := range [][4]int{{1, 2, 0, 2}, {3, 1, 1, 0}, {2, 3, 0, 0}} {
+		matchRepo.Create(newFinishedMatch(1, uint(result[0]), uint(result[1]), result[2], result[3]))
+	}
+	match := newScheduledMatch(1, 1, 3)
+	matchRepo.Create(match)
+
+	prediction, err := service.PredictMatch(match.ID)
+	if err != nil {
+		t.Fatalf("PredictMatch() error = %v", err)
+	}
+
+	// Team 1 never scored and team 3 never conceded
+	if prediction.HomeAttack <= 0 || prediction.AwayDefence <= 0 || prediction.HomeExpectedGoals <= 0 {
+		t.Errorf("prediction = %+v, want team 1 expected to score", prediction)
+	}
+	if prediction.HomeWinProbability <= 0 || prediction.AwayWinProbability >= 1 {
+		t.Errorf("home win = %.5f, away win = %.5f, want no certain outcome", prediction.HomeWinProbability, prediction.AwayWinProbability)
+	}
+	if prediction.HomeAttack >= 1 || prediction.AwayDefence >= 1 {
+		t.Errorf("attack = %.5f, defence = %.5f, want both below average", prediction.HomeAttack, prediction.AwayDefence)
+	}
+}
+
+// TestPredictionService_NoCompletedMatches tests that a season without results cannot be predicted
+func TestPredictionService_NoCompletedMatches(t *testing.T) {
+	matchRepo := NewMockMatchRepository()
+	service := NewPredictionService(matchRepo)
+	match := newScheduledMatch(1, 1, 2)
+	matchRepo.Create(match)
+
+	if _, err := service.PredictMatch(match.ID); err == nil {
+		t.Error("PredictMatch() without completed matches should fail")
+	}
+	if _, err := service.PredictMatch(99); err == nil {
+		t.Error("PredictMatch() of a missing match should fail")
+	}
+}
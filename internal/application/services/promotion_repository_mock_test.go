@@ -0,0 +1,107 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"sort"
+)
+
+// MockPromotionRuleRepository is an in-memory implementation of PromotionRuleRepository for testing
+type MockPromotionRuleRepository struct {
+	rules  map[uint]*entities.PromotionRule
+	nextID uint
+}
+
+// NewMockPromotionRuleRepository creates a new mock promotion rule repository
+func NewMockPromotionRuleRepository() *MockPromotionRuleRepository {
+	return &MockPromotionRuleRepository{
+		rules:  make(map[uint]*entities.PromotionRule),
+		nextID: 1,
+	}
+}
+
+func (m *MockPromotionRuleRepository) Save(rule *entities.PromotionRule) error {
+	if rule.ID == 0 {
+		rule.ID = m.nextID
+		m.nextID++
+	}
+	stored := *rule
+	m.rules[rule.ID] = &stored
+	return nil
+}
+
+func (m *MockPromotionRuleRepository) Delete(id uint) error {
+	delete(m.rules, id)
+	return nil
+}
+
+func (m *MockPromotionRuleRepository) FindByUpperLeagueID(leagueID uint) (*entities.PromotionRule, error) {
+	for _, rule := range m.rules {
+		if rule.UpperLeagueID == leagueID {
+			found := *rule
+			return &found, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *MockPromotionRuleRepository) FindByLowerLeagueID(leagueID uint) (*entities.PromotionRule, error) {
+	for _, rule := range m.rules {
+		if rule.LowerLeagueID == leagueID {
+			found := *rule
+			return &found, nil
+		}
+	}
+	return nil, nil
+}
+
+// MockTeamMovementRepository is an in-memory implementation of TeamMovementRepository for testing
+type MockTeamMovementRepository struct {
+	movements []entities.TeamMovement
+	nextID    uint
+}
+
+// NewMockTeamMovementRepository creates a new mock team movement repository
+func NewMockTeamMovementRepository() *MockTeamMovementRepository {
+	return &MockTeamMovementRepository{nextID: 1}
+}
+
+func (m *MockTeamMovementRepository) ReplaceForSeason(seasonID uint, movements []entities.TeamMovement) error {
+	kept := make([]entities.TeamMovement, 0, len(m.movements))
+	for _, movement := range m.movements {
+		if movement.SeasonID != seasonID {
+			kept = append(kept, movement)
+		}
+	}
+	for i := range movements {
+		movements[i].ID = m.nextID
+		m.nextID++
+		kept = append(kept, movements[i])
+	}
+	m.movements = kept
+	return nil
+}
+
+func (m *MockTeamMovementRepository) GetBySeasonID(seasonID uint) ([]entities.TeamMovement, error) {
+	return m.filter(func(movement entities.TeamMovement) bool { return movement.SeasonID == seasonID }), nil
+}
+
+func (m *MockTeamMovementRepository) GetByToLeagueID(leagueID uint) ([]entities.TeamMovement, error) {
+	return m.filter(func(movement entities.TeamMovement) bool { return movement.ToLeagueID == leagueID }), nil
+}
+
+// filter returns the stored movements matching the predicate ordered by season and position
+func (m *MockTeamMovementRepository) filter(match func(movement entities.TeamMovement) bool) []entities.TeamMovement {
+	movements := make([]entities.TeamMovement, 0)
+	for _, movement := range m.movements {
+		if match(movement) {
+			movements = append(movements, movement)
+		}
+	}
+	sort.SliceStable(movements, func(i, j int) bool {
+		if movements[i].SeasonID != movements[j].SeasonID {
+			return movements[i].SeasonID < movements[j].SeasonID
+		}
+		return movements[i].Position < movements[j].Position
+	})
+	return movements
+}
@@ -0,0 +1,229 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"errors"
+	"fmt"
+)
+
+// PromotionService handles the promotion and relegation of teams between linked leagues
+type PromotionService struct {
+	ruleRepo           repositories.PromotionRuleRepository
+	movementRepo       repositories.TeamMovementRepository
+	leagueRepo         repositories.LeagueRepository
+	matchRepo          repositories.MatchRepository
+	leaderboardService *LeaderboardService
+}
+
+// NewPromotionService creates a new promotion service instance
+func NewPromotionService(ruleRepo repositories.PromotionRuleRepository, movementRepo repositories.TeamMovementRepository, leagueRepo repositories.LeagueRepository, matchRepo repositories.MatchRepository, leaderboardService *LeaderboardService) *PromotionService {
+	return &PromotionService{
+		ruleRepo:           ruleRepo,
+		movementRepo:       movementRepo,
+		leagueRepo:         leagueRepo,
+		matchRepo:          matchRepo,
+		leaderboardService: leaderboardService,
+	}
+}
+
+// GetPromotionRule retrieves the rule linking a league to the league below it
+func (s *PromotionService) GetPromotionRule(upperLeagueID uint) (*entities.PromotionRule, error) {
+	if upperLeagueID == 0 {
+		return nil, errors.New("invalid league ID")
+	}
+
+	rule, err := s.ruleRepo.FindByUpperLeagueID(upperLeagueID)
+	if err != nil {
+		return nil, err
+	}
+	if rule == nil {
+		return nil, errors.New("league has no promotion rule")
+	}
+	return rule, nil
+}
+
+// SetPromotionRule creates or replaces the rule linking a league to the league one tier below it
+func (s *PromotionService) SetPromotionRule(upperLeagueID uint, rule *entities.PromotionRule) error {
+	if upperLeagueID == 0 {
+		return errors.New("invalid league ID")
+	}
+	rule.UpperLeagueID = upperLeagueID
+
+	if rule.LowerLeagueID == 0 || rule.LowerLeagueID == upperLeagueID {
+		return errors.New("a different lower league is required")
+	}
+
+	if rule.PromotedTeams < 0 || rule.RelegatedTeams < 0 {
+		return errors.New("number of teams moving cannot be negative")
+	}
+
+	upper, err := s.leagueRepo.GetByID(rule.UpperLeagueID)
+	if err != nil {
+		return err
+	}
+	lower, err := s.leagueRepo.GetByID(rule.LowerLeagueID)
+	if err != nil {
+		return err
+	}
+	if upper.Tier == 0 || lower.Tier != upper.Tier+1 {
+		return fmt.Errorf("lower league must be one tier below the upper league (tiers %d and %d)", upper.Tier, lower.Tier)
+	}
+
+	linked, err := s.ruleRepo.FindByLowerLeagueID(rule.LowerLeagueID)
+	if err != nil {
+		return err
+	}
+	if linked != nil && linked.UpperLeagueID != upperLeagueID {
+		return fmt.Errorf("league %d is already linked below league %d", rule.LowerLeagueID, linked.UpperLeagueID)
+	}
+
+	existing, err := s.ruleRepo.FindByUpperLeagueID(upperLeagueID)
+	if err != nil {
+		return err
+	}
+	rule.ID = 0
+	if existing != nil {
+		rule.ID = existing.ID
+		rule.CreatedAt = existing.CreatedAt
+	}
+
+	return s.ruleRepo.Save(rule)
+}
+
+// DeletePromotionRule removes the link between a league and the league below it
+func (s *PromotionService) DeletePromotionRule(upperLeagueID uint) error {
+	rule, err := s.GetPromotionRule(upperLeagueID)
+	if err != nil {
+		return err
+	}
+
+	return s.ruleRepo.Delete(rule.ID)
+}
+
+// GetMovements retrieves the teams that leave the league of a completed season
+func (s *PromotionService) GetMovements(seasonID uint) ([]entities.TeamMovement, error) {
+	if seasonID == 0 {
+		return nil, errors.New("invalid season ID")
+	}
+
+	return s.movementRepo.GetBySeasonID(seasonID)
+}
+
+// SeasonCompleted computes the teams promoted and relegated out of the league of a season from
+// its final leaderboard; it is registered as a season completed hook
+func (s *PromotionService) SeasonCompleted(season *entities.Season) error {
+	movements, err := s.ComputeMovements(season)
+	if err != nil {
+		return err
+	}
+
+	return s.movementRepo.ReplaceForSeason(season.ID, movements)
+}
+
+// ComputeMovements returns the teams leaving the league of a season: the bottom teams are
+// relegated to the league below and the top teams, plus the playoff winner, are promoted to
+// the league above
+func (s *PromotionService) ComputeMovements(season *entities.Season) ([]entities.TeamMovement, error) {
+	down, err := s.ruleRepo.FindByUpperLeagueID(season.LeagueID)
+	if err != nil {
+		return nil, err
+	}
+	up, err := s.ruleRepo.FindByLowerLeagueID(season.LeagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	movements := make([]entities.TeamMovement, 0)
+	if down == nil && up == nil {
+		return movements, nil
+	}
+
+	leaderboard, err := s.leaderboardService.GenerateLeaderboard(season.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if up != nil {
+		if len(leaderboard) < up.PromotedTeams {
+			return nil, fmt.Errorf("only %d teams in the final standings, %d to promote", len(leaderboard), up.PromotedTeams)
+		}
+
+		promoted := make(map[uint]bool)
+		for i := 0; i < up.PromotedTeams; i++ {
+			promoted[leaderboard[i].TeamID] = true
+			movements = append(movements, newTeamMovement(season, up.UpperLeagueID, entities.MovementPromoted, leaderboard, i, false))
+		}
+
+		if up.Playoff {
+			winner, err := s.playoffWinner(season.ID)
+			if err != nil {
+				return nil, err
+			}
+			if promoted[winner] {
+				return nil, fmt.Errorf("promotion playoff winner %d is already promoted automatically", winner)
+			}
+			position := leaderboardPosition(leaderboard, winner)
+			if position < 0 {
+				return nil, fmt.Errorf("promotion playoff winner %d is not in the final standings", winner)
+			}
+			movements = append(movements, newTeamMovement(season, up.UpperLeagueID, entities.MovementPromoted, leaderboard, position, true))
+		}
+	}
+
+	if down != nil {
+		relegated := down.RelegatedTeams
+		if down.Playoff {
+			relegated++
+		}
+		if len(leaderboard) < relegated {
+			return nil, fmt.Errorf("only %d teams in the final standings, %d to relegate", len(leaderboard), relegated)
+		}
+
+		for i := len(leaderboard) - relegated; i < len(leaderboard); i++ {
+			// With a playoff the best placed of the relegated teams makes room for the playoff winner
+			viaPlayoff := down.Playoff && i == len(leaderboard)-relegated
+			movements = append(movements, newTeamMovement(season, down.LowerLeagueID, entities.MovementRelegated, leaderboard, i, viaPlayoff))
+		}
+	}
+
+	return movements, nil
+}
+
+// playoffWinner returns the winner of the knockout final of a season
+func (s *PromotionService) playoffWinner(seasonID uint) (uint, error) {
+	finals, err := s.matchRepo.GetByStage(seasonID, entities.MatchStageFinal)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range finals {
+		if winner, _, ok := matchWinner(&finals[i]); ok {
+			return winner, nil
+		}
+	}
+	return 0, errors.New("promotion playoff final has not been decided")
+}
+
+// newTeamMovement records the team at the given index of the leaderboard moving to another league
+func newTeamMovement(season *entities.Season, toLeagueID uint, direction entities.MovementDirection, leaderboard entities.Leaderboard, index int, viaPlayoff bool) entities.TeamMovement {
+	return entities.TeamMovement{
+		SeasonID:     season.ID,
+		TeamID:       leaderboard[index].TeamID,
+		FromLeagueID: season.LeagueID,
+		ToLeagueID:   toLeagueID,
+		Direction:    direction,
+		Position:     index + 1,
+		ViaPlayoff:   viaPlayoff,
+	}
+}
+
+// leaderboardPosition returns the index of a team in the leaderboard, or -1 if it is missing
+func leaderboardPosition(leaderboard entities.Leaderboard, teamID uint) int {
+	for i, entry := range leaderboard {
+		if entry.TeamID == teamID {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,180 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"testing"
+	"time"
+)
+
+// promotionFixture links league 1 (season 1, teams 1-4) above league 2 (season 2, teams 5-8)
+type promotionFixture struct {
+	service       *PromotionService
+	seasonService *SeasonService
+	seasonRepo    *MockSeasonRepository
+	matchRepo     *MockMatchRepository
+	movementRepo  *MockTeamMovementRepository
+}
+
+// newPromotionFixture creates linked leagues whose lower team always beats the higher one
+// in every season, so that the final standings follow the team IDs
+func newPromotionFixture(t *testing.T, rule entities.PromotionRule) *promotionFixture {
+	ruleSetService, ruleSetRepo, seasonRepo := newTestRuleSetService()
+	matchRepo := NewMockMatchRepository()
+	seasonRepo.ruleSetRepo = ruleSetRepo
+	seasonRepo.matchRepo = matchRepo
+	seasonRepo.Create(&entities.Season{
+		ID:       2,
+		LeagueID: 2,
+		Name:     "2025",
+		StartsAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndsAt:   time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+	})
+	enrollTeams(seasonRepo, 1, 1, 2, 3, 4)
+	enrollTeams(seasonRepo, 2, 5, 6, 7, 8)
+	for _, teams := range [][]uint{{1, 2, 3, 4}, {5, 6, 7, 8}} {
+		seasonID := uint(1)
+		if teams[0] == 5 {
+			seasonID = 2
+		}
+		for i, home := range teams {
+			for _, away := range teams[i+1:] {
+				matchRepo.Create(newFinishedMatch(seasonID, home, away, 2, 0))
+			}
+		}
+	}
+
+	leagueRepo := NewMockLeagueRepository()
+	leagueRepo.Create(&entities.League{ID: 1, Name: "Premier", Tier: 1})
+	leagueRepo.Create(&entities.League{ID: 2, Name: "Championship", Tier: 2})
+
+	movementRepo := NewMockTeamMovementRepository()
+	leaderboardService := NewLeaderboardService(matchRepo, NewMockMatchPlayerRepository(matchRepo), ruleSetService, NewMockTeamSanctionRepository())
+	service := NewPromotionService(NewMockPromotionRuleRepository(), movementRepo, leagueRepo, matchRepo, leaderboardService)
+	if err := service.SetPromotionRule(1, &rule); err != nil {
+		t.Fatalf("SetPromotionRule() error = %v", err)
+	}
+
+	seasonService := NewSeasonService(seasonRepo, ruleSetRepo, matchRepo, movementRepo)
+	seasonService.OnSeasonCompleted(service.SeasonCompleted)
+
+	return &promotionFixture{
+		service:       service,
+		seasonService: seasonService,
+		seasonRepo:    seasonRepo,
+		matchRepo:     matchRepo,
+		movementRepo:  movementRepo,
+	}
+}
+
+// movementSummary lists the team, destination league and playoff flag of each movement
+func movementSummary(movements []entities.TeamMovement) [][3]uint {
+	summary := make([][3]uint, 0, len(movements))
+	for _, movement := range movements {
+		viaPlayoff := uint(0)
+		if movement.ViaPlayoff {
+			viaPlayoff = 1
+		}
+		summary = append(summary, [3]uint{movement.TeamID, movement.ToLeagueID, viaPlayoff})
+	}
+	return summary
+}
+
+// assertMovements checks the movements of a season against the expected summary
+func assertMovements(t *testing.T, f *promotionFixture, seasonID uint, want [][3]uint) {
+	t.Helper()
+	movements, err := f.service.GetMovements(seasonID)
+	if err != nil {
+		t.Fatalf("GetMovements() error = %v", err)
+	}
+	got := movementSummary(movements)
+	if len(got) != len(want) {
+		t.Fatalf("season %d movements = %v, want %v", seasonID, got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("season %d movements = %v, want %v", seasonID, got, want)
+			return
+		}
+	}
+}
+
+// TestPromotionService_SeasonCompleted tests that completing a season moves its top and
+// bottom teams and that a rollover of the upper league applies the movements
+func TestPromotionService_SeasonCompleted(t *testing.T) {
+	f := newPromotionFixture(t, entities.PromotionRule{LowerLeagueID: 2, PromotedTeams: 1, RelegatedTeams: 1})
+
+	for _, seasonID := range []uint{1, 2} {
+		if err := f.seasonService.CompleteSeason(seasonID); err != nil {
+			t.Fatalf("CompleteSeason(%d) error = %v", seasonID, err)
+		}
+	}
+	assertMovements(t, f, 1, [][3]uint{{4, 2, 0}})
+	assertMovements(t, f, 2, [][3]uint{{5, 1, 0}})
+
+	season, _, err := f.seasonService.RolloverSeason(1, RolloverOptions{Name: "2026", ApplyMovements: true})
+	if err != nil {
+		t.Fatalf("RolloverSeason() error = %v", err)
+	}
+	if got := seasonTeamIDs(season); len(got) != 4 || got[0] != 1 || got[1] != 2 || got[2] != 3 || got[3] != 5 {
+		t.Errorf("new season teams = %v, want [1 2 3 5]", got)
+	}
+}
+
+// TestPromotionService_Playoff tests that the lower league's final winner takes an extra
+// promotion place in exchange for one more relegation
+func TestPromotionService_Playoff(t *testing.T) {
+	f := newPromotionFixture(t, entities.PromotionRule{LowerLeagueID: 2, PromotedTeams: 1, RelegatedTeams: 1, Playoff: true})
+
+	if err := f.seasonService.CompleteSeason(2); err == nil {
+		t.Fatal("CompleteSeason() before the playoff final should fail")
+	}
+	if season, _ := f.seasonRepo.GetByID(2); season.Status == entities.SeasonStatusCompleted {
+		t.Error("season was completed although its promotion hook failed")
+	}
+
+	final := newFinishedMatch(2, 6, 7, 0, 1)
+	final.Stage = entities.MatchStageFinal
+	f.matchRepo.Create(final)
+
+	for _, seasonID := range []uint{1, 2} {
+		if err := f.seasonService.CompleteSeason(seasonID); err != nil {
+			t.Fatalf("CompleteSeason(%d) error = %v", seasonID, err)
+		}
+	}
+	assertMovements(t, f, 1, [][3]uint{{3, 2, 1}, {4, 2, 0}})
+	assertMovements(t, f, 2, [][3]uint{{5, 1, 0}, {7, 1, 1}})
+}
+
+// TestPromotionService_SetPromotionRule tests that only leagues one tier apart can be linked
+func TestPromotionService_SetPromotionRule(t *testing.T) {
+	f := newPromotionFixture(t, entities.PromotionRule{LowerLeagueID: 2, PromotedTeams: 2, RelegatedTeams: 2})
+	f.service.leagueRepo.Create(&entities.League{ID: 3, Name: "League One", Tier: 3})
+	f.service.leagueRepo.Create(&entities.League{ID: 4, Name: "Cup"})
+
+	tests := []struct {
+		name    string
+		upperID uint
+		rule    entities.PromotionRule
+		wantErr bool
+	}{
+		{name: "one tier below", upperID: 2, rule: entities.PromotionRule{LowerLeagueID: 3, PromotedTeams: 3, RelegatedTeams: 3}},
+		{name: "two tiers below", upperID: 1, rule: entities.PromotionRule{LowerLeagueID: 3}, wantErr: true},
+		{name: "untiered league", upperID: 4, rule: entities.PromotionRule{LowerLeagueID: 1}, wantErr: true},
+		{name: "same league", upperID: 1, rule: entities.PromotionRule{LowerLeagueID: 1}, wantErr: true},
+		{name: "negative teams", upperID: 2, rule: entities.PromotionRule{LowerLeagueID: 3, PromotedTeams: -1}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := f.service.SetPromotionRule(tt.upperID, &tt.rule)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SetPromotionRule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	rule, err := f.service.GetPromotionRule(1)
+	if err != nil || rule.LowerLeagueID != 2 || rule.PromotedTeams != 2 {
+		t.Errorf("GetPromotionRule(1) = %+v, %v, want the original rule", rule, err)
+	}
+}
@@ -0,0 +1,302 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"errors"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+)
+
+// RatingConfig holds the parameters of the Elo rating engine
+type RatingConfig struct {
+	// InitialRating is the rating of a team before its first rated match
+	InitialRating float64
+	// KFactor is the largest rating change a single match can cause
+	KFactor float64
+	// HomeAdvantage is added to the rating of the home team when predicting the result
+	HomeAdvantage float64
+	// GoalDifferenceMultiplier scales the rating change up for wins by two goals or more
+	GoalDifferenceMultiplier bool
+}
+
+// DefaultRatingConfig returns the rating parameters used when none are configured
+func DefaultRatingConfig() RatingConfig {
+	return RatingConfig{
+		InitialRating:            1500,
+		KFactor:                  20,
+		HomeAdvantage:            100,
+		GoalDifferenceMultiplier: true,
+	}
+}
+
+// RatingConfigFromEnv reads the rating parameters from the RATING_INITIAL, RATING_K_FACTOR,
+// RATING_HOME_ADVANTAGE and RATING_GOAL_DIFFERENCE environment variables, falling back to
+// the defaults for unset or invalid values
+func RatingConfigFromEnv() RatingConfig {
+	config := DefaultRatingConfig()
+	if value, err := strconv.ParseFloat(os.Getenv("RATING_INITIAL"), 64); err == nil {
+		config.InitialRating = value
+	}
+	if value, err := strconv.ParseFloat(os.Getenv("RATING_K_FACTOR"), 64); err == nil && value > 0 {
+		config.KFactor = value
+	}
+	if value, err := strconv.ParseFloat(os.Getenv("RATING_HOME_ADVANTAGE"), 64); err == nil {
+		config.HomeAdvantage = value
+	}
+	if value, err := strconv.ParseBool(os.Getenv("RATING_GOAL_DIFFERENCE")); err == nil {
+		config.GoalDifferenceMultiplier = value
+	}
+	return config
+}
+
+// RatingService rates the strength of teams across seasons and leagues with the Elo system,
+// replaying their finished matches in the order they were played
+type RatingService struct {
+	ratingRepo repositories.TeamRatingRepository
+	matchRepo  repositories.MatchRepository
+	seasonRepo repositories.SeasonRepository
+	teamRepo   repositories.TeamRepository
+	config     RatingConfig
+}
+
+// NewRatingService creates a new rating service instance
+func NewRatingService(ratingRepo repositories.TeamRatingRepository, matchRepo repositories.MatchRepository, seasonRepo repositories.SeasonRepository, teamRepo repositories.TeamRepository, config RatingConfig) *RatingService {
+	return &RatingService{
+		ratingRepo: ratingRepo,
+		matchRepo:  matchRepo,
+		seasonRepo: seasonRepo,
+		teamRepo:   teamRepo,
+		config:     config,
+	}
+}
+
+// Recompute replays every finished match from scratch and replaces the stored ratings,
+// returning the number of matches rated
+func (s *RatingService) Recompute() (int, error) {
+	matches, err := s.matchRepo.GetFinished()
+	if err != nil {
+		return 0, err
+	}
+
+	current := make(map[uint]float64)
+	ratings := make([]entities.TeamRating, 0, 2*len(matches))
+	rated := 0
+	for i := range matches {
+		if !rateable(&matches[i]) {
+			continue
+		}
+		ratings = append(ratings, s.rate(&matches[i], current)...)
+		rated++
+	}
+
+	if err := s.ratingRepo.ReplaceAll(ratings); err != nil {
+		return 0, err
+	}
+	return rated, nil
+}
+
+// MatchFinished rates a newly finished match; it is registered as a match finished hook.
+// A match that was rated before or that was played before the latest rated match changes
+// the ratings after it, so every match is replayed instead.
+func (s *RatingService) MatchFinished(match *entities.Match) error {
+	rated, err := s.ratingRepo.GetByMatchID(match.ID)
+	if err != nil {
+		return err
+	}
+	if len(rated) > 0 {
+		_, err := s.Recompute()
+		return err
+	}
+
+	if !rateable(match) {
+		return nil
+	}
+
+	latest, err := s.ratingRepo.GetLatest()
+	if err != nil {
+		return err
+	}
+
+	current := make(map[uint]float64, len(latest))
+	for _, rating := range latest {
+		current[rating.TeamID] = rating.Rating
+		if !playedBefore(entities.Match{ID: rating.MatchID, Date: rating.Date}, *match) {
+			_, err := s.Recompute()
+			return err
+		}
+	}
+
+	return s.ratingRepo.Create(s.rate(match, current))
+}
+
+// GetTeamHistory retrieves the rating of a team after each of its rated matches
+func (s *RatingService) GetTeamHistory(teamID uint) ([]entities.TeamRating, error) {
+	if teamID == 0 {
+		return nil, errors.New("invalid team ID")
+	}
+
+	return s.ratingRepo.GetByTeamID(teamID)
+}
+
+// GetRankings ranks teams by their current rating. With a league ID only the teams of the
+// most recent season of that league are ranked.
+func (s *RatingService) GetRankings(leagueID uint) ([]entities.TeamRanking, error) {
+	teams, err := s.rankedTeams(leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := s.ratingRepo.GetLatest()
+	if err != nil {
+		return nil, err
+	}
+	byTeam := make(map[uint]entities.TeamRating, len(latest))
+	for _, rating := range latest {
+		byTeam[rating.TeamID] = rating
+	}
+
+	rankings := make([]entities.TeamRanking, 0, len(teams))
+	for _, team := range teams {
+		ranking := entities.TeamRanking{TeamID: team.ID, TeamName: team.Name, Rating: s.config.InitialRating}
+		if rating, ok := byTeam[team.ID]; ok {
+			date := rating.Date
+			ranking.Rating = rating.Rating
+			ranking.LastMatchDate = &date
+		}
+		rankings = append(rankings, ranking)
+	}
+
+	sort.SliceStable(rankings, func(i, j int) bool {
+		if rankings[i].Rating != rankings[j].Rating {
+			return rankings[i].Rating > rankings[j].Rating
+		}
+		return rankings[i].TeamName < rankings[j].TeamName
+	})
+	for i := range rankings {
+		rankings[i].Position = i + 1
+	}
+
+	return rankings, nil
+}
+
+// rankedTeams returns every team, or the teams of the most recent season of a league
+func (s *RatingService) rankedTeams(leagueID uint) ([]entities.Team, error) {
+	if leagueID == 0 {
+		return s.teamRepo.GetAll()
+	}
+
+	seasons, err := s.seasonRepo.GetByLeagueID(leagueID)
+	if err != nil {
+		return nil, err
+	}
+	if len(seasons) == 0 {
+		return nil, errors.New("league has no seasons")
+	}
+
+	latest := seasons[0]
+	for _, season := range seasons[1:] {
+		if season.StartsAt.After(latest.StartsAt) {
+			latest = season
+		}
+	}
+
+	season, err := s.seasonRepo.GetWithTeams(latest.ID)
+	if err != nil {
+		return nil, err
+	}
+	return season.Teams, nil
+}
+
+// rate updates the current ratings of both teams with the result of a match and returns
+// the ratings to store for it
+func (s *RatingService) rate(match *entities.Match, current map[uint]float64) []entities.TeamRating {
+	home, away := s.currentRating(current, match.HomeTeamID), s.currentRating(current, match.AwayTeamID)
+	homeGoals, awayGoals := ratedScore(match)
+
+	change := s.ratingChange(home, away, homeGoals, awayGoals)
+	current[match.HomeTeamID] = home + change
+	current[match.AwayTeamID] = away - change
+
+	return []entities.TeamRating{
+		newTeamRating(match, match.HomeTeamID, match.AwayTeamID, home, home+change),
+		newTeamRating(match, match.AwayTeamID, match.HomeTeamID, away, away-change),
+	}
+}
+
+// ratingChange returns the points the home team gains, and the away team loses, from a result
+func (s *RatingService) ratingChange(home, away float64, homeGoals, awayGoals int) float64 {
+	expected := 1 / (1 + math.Pow(10, -(home+s.config.HomeAdvantage-away)/400))
+
+	actual := 0.5
+	if homeGoals > awayGoals {
+		actual = 1
+	} else if homeGoals < awayGoals {
+		actual = 0
+	}
+
+	multiplier := 1.0
+	if s.config.GoalDifferenceMultiplier {
+		multiplier = goalDifferenceMultiplier(homeGoals - awayGoals)
+	}
+
+	return s.config.KFactor * multiplier * (actual - expected)
+}
+
+// currentRating returns the rating of a team, or the initial rating before its first match
+func (s *RatingService) currentRating(current map[uint]float64, teamID uint) float64 {
+	if rating, ok := current[teamID]; ok {
+		return rating
+	}
+	return s.config.InitialRating
+}
+
+// goalDifferenceMultiplier weighs a result by its margin: wins by two goals count one and a
+// half times, and larger wins (11 + margin) / 8 times
+func goalDifferenceMultiplier(goalDifference int) float64 {
+	if goalDifference < 0 {
+		goalDifference = -goalDifference
+	}
+
+	switch {
+	case goalDifference <= 1:
+		return 1
+	case goalDifference == 2:
+		return 1.5
+	default:
+		return (11 + float64(goalDifference)) / 8
+	}
+}
+
+// rateable reports whether a match was played to a result; matches settled by an
+// administrative result are not rated
+func rateable(match *entities.Match) bool {
+	return match.Status == string(entities.MatchStatusFinished) && !match.IsAdministrative() &&
+		match.HomeTeamScore != nil && match.AwayTeamScore != nil
+}
+
+// ratedScore returns the score a match is rated on: the goals of regulation and extra
+// time. A match decided by a penalty shootout is rated as a draw.
+func ratedScore(match *entities.Match) (int, int) {
+	homeGoals, awayGoals := *match.HomeTeamScore, *match.AwayTeamScore
+	if match.HomeExtraTimeScore != nil && match.AwayExtraTimeScore != nil {
+		homeGoals += *match.HomeExtraTimeScore
+		awayGoals += *match.AwayExtraTimeScore
+	}
+	return homeGoals, awayGoals
+}
+
+// newTeamRating records the rating of a team after a match
+func newTeamRating(match *entities.Match, teamID, opponentID uint, before, after float64) entities.TeamRating {
+	return entities.TeamRating{
+		TeamID:       teamID,
+		MatchID:      match.ID,
+		SeasonID:     match.SeasonID,
+		OpponentID:   opponentID,
+		Date:         match.Date,
+		RatingBefore: before,
+		Rating:       after,
+	}
+}
@@ -0,0 +1,149 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"math"
+	"testing"
+	"time"
+)
+
+// newTestRatingService creates a rating service with the default parameters, teams 1 to 4
+// and season 1 of league 1
+func newTestRatingService() (*RatingService, *MockTeamRatingRepository, *MockMatchRepository, *MockSeasonRepository) {
+	ratingRepo := NewMockTeamRatingRepository()
+	matchRepo := NewMockMatchRepository()
+	_, _, seasonRepo := newTestRuleSetService()
+	service := NewRatingService(ratingRepo, matchRepo, seasonRepo, NewMockTeamRepository(1, 2, 3, 4), DefaultRatingConfig())
+	return service, ratingRepo, matchRepo, seasonRepo
+}
+
+// newDatedMatch creates a finished match of season 1 on the given day of March
+func newDatedMatch(day int, homeID, awayID uint, homeScore, awayScore int) *entities.Match {
+	match := newFinishedMatch(1, homeID, awayID, homeScore, awayScore)
+	match.Date = time.Date(2025, 3, day, 15, 0, 0, 0, time.UTC)
+	return match
+}
+
+// assertRating fails the test when a rating differs from the expected value by more than a rounding error
+func assertRating(t *testing.T, name string, got, want float64) {
+	t.Helper()
+	if math.Abs(got-want) > 0.01 {
+		t.Errorf("%s = %.2f, want %.2f", name, got, want)
+	}
+}
+
+// TestRatingService_RatingChange tests the Elo update against known values
+func TestRatingService_RatingChange(t *testing.T) {
+	service, _, _, _ := newTestRatingService()
+
+	tests := []struct {
+		name                 string
+		home, away           float64
+		homeGoals, awayGoals int
+		want                 float64
+	}{
+		// The home advantage makes the home team a 64% favourite between equal teams
+		{name: "home win", home: 1500, away: 1500, homeGoals: 1, awayGoals: 0, want: 7.20},
+		{name: "draw", home: 1500, away: 1500, homeGoals: 2, awayGoals: 2, want: -2.80},
+		{name: "away win by two", home: 1500, away: 1500, homeGoals: 0, awayGoals: 2, want: -19.20},
+		{name: "home win by four", home: 1400, away: 1600, homeGoals: 4, awayGoals: 0, want: 24.00},
+	}
+
+	for _, tt := range tests {
+		assertRating(t, tt.name, service.ratingChange(tt.home, tt.away, tt.homeGoals, tt.awayGoals), tt.want)
+	}
+}
+
+// TestRatingService_MatchFinished tests that newer matches are rated incrementally, that
+// older or corrected matches replay everything, and that both give the same ratings
+func TestRatingService_MatchFinished(t *testing.T) {
+	service, ratingRepo, matchRepo, _ := newTestRatingService()
+
+	first := newDatedMatch(1, 1, 2, 1, 0)
+	matchRepo.Create(first)
+	second := newDatedMatch(8, 2, 1, 0, 2)
+	matchRepo.Create(second)
+	for _, match := range []*entities.Match{first, second} {
+		if err := service.MatchFinished(match); err != nil {
+			t.Fatalf("MatchFinished() error = %v", err)
+		}
+	}
+	if ratingRepo.replaced != 0 {
+		t.Errorf("matches played in order were replayed %d times", ratingRepo.replaced)
+	}
+
+	history, err := service.GetTeamHistory(1)
+	if err != nil {
+		t.Fatalf("GetTeamHistory() error = %v", err)
+	}
+	if len(history) != 2 || history[1].RatingBefore != history[0].Rating {
+		t.Fatalf("history = %+v, want two chained ratings", history)
+	}
+	incremental := history[1].Rating
+
+	// A match played before the latest rated one replays the season
+	earlier := newDatedMatch(4, 3, 1, 0, 0)
+	matchRepo.Create(earlier)
+	if err := service.MatchFinished(earlier); err != nil {
+		t.Fatalf("MatchFinished() error = %v", err)
+	}
+	if ratingRepo.replaced != 1 {
+		t.Errorf("an earlier match was replayed %d times, want once", ratingRepo.replaced)
+	}
+	if history, _ := service.GetTeamHistory(1); len(history) != 3 || history[1].MatchID != earlier.ID {
+		t.Errorf("history = %+v, want the earlier match rated in between", history)
+	}
+
+	// Administrative results are not rated
+	matchRepo.Delete(earlier.ID)
+	walkover := newDatedMatch(15, 1, 4, 3, 0)
+	walkover.AdministrativeResult = entities.AdministrativeWalkover
+	matchRepo.Create(walkover)
+	rated, err := service.Recompute()
+	if err != nil {
+		t.Fatalf("Recompute() error = %v", err)
+	}
+	if rated != 2 {
+		t.Errorf("Recompute() rated %d matches, want 2", rated)
+	}
+	history, _ = service.GetTeamHistory(1)
+	assertRating(t, "rating after a replay", history[len(history)-1].Rating, incremental)
+}
+
+// TestRatingService_GetRankings tests that rankings order teams by rating and only list the
+// teams of the latest season of a league
+func TestRatingService_GetRankings(t *testing.T) {
+	service, _, matchRepo, seasonRepo := newTestRatingService()
+	enrollTeams(seasonRepo, 1, 1, 2, 3)
+	seasonRepo.Create(&entities.Season{ID: 2, LeagueID: 1, Name: "2026", StartsAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+	enrollTeams(seasonRepo, 2, 2, 3, 4)
+
+	matchRepo.Create(newDatedMatch(1, 1, 2, 3, 0))
+	matchRepo.Create(newDatedMatch(8, 3, 2, 1, 0))
+	if _, err := service.Recompute(); err != nil {
+		t.Fatalf("Recompute() error = %v", err)
+	}
+
+	rankings, err := service.GetRankings(0)
+	if err != nil {
+		t.Fatalf("GetRankings() error = %v", err)
+	}
+	order := make([]uint, 0, len(rankings))
+	for _, ranking := range rankings {
+		order = append(order, ranking.TeamID)
+	}
+	if len(order) != 4 || order[0] != 1 || order[1] != 3 || order[2] != 4 || order[3] != 2 {
+		t.Errorf("rankings = %v, want [1 3 4 2]", order)
+	}
+	if rankings[2].Rating != 1500 || rankings[2].LastMatchDate != nil || rankings[0].Position != 1 {
+		t.Errorf("rankings = %+v, want unrated team 4 on the initial rating", rankings)
+	}
+
+	league, err := service.GetRankings(1)
+	if err != nil {
+		t.Fatalf("GetRankings() error = %v", err)
+	}
+	if len(league) != 3 || league[0].TeamID != 3 || league[2].TeamID != 2 {
+		t.Errorf("league rankings = %+v, want teams 3, 4 and 2 of the 2026 season", league)
+	}
+}
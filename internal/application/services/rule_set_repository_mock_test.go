@@ -0,0 +1,54 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+)
+
+// MockRuleSetRepository is an in-memory implementation of RuleSetRepository for testing
+type MockRuleSetRepository struct {
+	ruleSets map[uint]*entities.RuleSet
+	nextID   uint
+}
+
+// NewMockRuleSetRepository creates a new mock rule set repository
+func NewMockRuleSetRepository() *MockRuleSetRepository {
+	return &MockRuleSetRepository{
+		ruleSets: make(map[uint]*entities.RuleSet),
+		nextID:   1,
+	}
+}
+
+func (m *MockRuleSetRepository) Save(ruleSet *entities.RuleSet) error {
+	if ruleSet.ID == 0 {
+		ruleSet.ID = m.nextID
+		m.nextID++
+	}
+	stored := *ruleSet
+	m.ruleSets[ruleSet.ID] = &stored
+	return nil
+}
+
+func (m *MockRuleSetRepository) Delete(id uint) error {
+	delete(m.ruleSets, id)
+	return nil
+}
+
+func (m *MockRuleSetRepository) FindByLeagueID(leagueID uint) (*entities.RuleSet, error) {
+	for _, ruleSet := range m.ruleSets {
+		if ruleSet.LeagueID != nil && *ruleSet.LeagueID == leagueID {
+			found := *ruleSet
+			return &found, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *MockRuleSetRepository) FindBySeasonID(seasonID uint) (*entities.RuleSet, error) {
+	for _, ruleSet := range m.ruleSets {
+		if ruleSet.SeasonID != nil && *ruleSet.SeasonID == seasonID {
+			found := *ruleSet
+			return &found, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"errors"
+	"fmt"
+)
+
+// RuleSetService handles the points and tiebreaker rules of leagues and seasons
+type RuleSetService struct {
+	ruleSetRepo repositories.RuleSetRepository
+	seasonRepo  repositories.SeasonRepository
+}
+
+// NewRuleSetService creates a new rule set service instance
+func NewRuleSetService(ruleSetRepo repositories.RuleSetRepository, seasonRepo repositories.SeasonRepository) *RuleSetService {
+	return &RuleSetService{
+		ruleSetRepo: ruleSetRepo,
+		seasonRepo:  seasonRepo,
+	}
+}
+
+// GetLeagueRules retrieves the rules of a league, falling back to the default rules
+func (s *RuleSetService) GetLeagueRules(leagueID uint) (*entities.RuleSet, error) {
+	if leagueID == 0 {
+		return nil, errors.New("invalid league ID")
+	}
+
+	ruleSet, err := s.ruleSetRepo.FindByLeagueID(leagueID)
+	if err != nil {
+		return nil, err
+	}
+	if ruleSet == nil {
+		return entities.DefaultRuleSet(), nil
+	}
+
+	return ruleSet, nil
+}
+
+// ResolveForSeason retrieves the rules that apply to a season: its own rules if it has any,
+// otherwise the rules of its league, otherwise the default rules
+func (s *RuleSetService) ResolveForSeason(seasonID uint) (*entities.RuleSet, error) {
+	if seasonID == 0 {
+		return nil, errors.New("invalid season ID")
+	}
+
+	ruleSet, err := s.ruleSetRepo.FindBySeasonID(seasonID)
+	if err != nil {
+		return nil, err
+	}
+	if ruleSet != nil {
+		return ruleSet, nil
+	}
+
+	season, err := s.seasonRepo.GetByID(seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetLeagueRules(season.LeagueID)
+}
+
+// SetLeagueRules creates or replaces the rules of a league
+func (s *RuleSetService) SetLeagueRules(leagueID uint, ruleSet *entities.RuleSet) error {
+	if leagueID == 0 {
+		return errors.New("invalid league ID")
+	}
+
+	existing, err := s.ruleSetRepo.FindByLeagueID(leagueID)
+	if err != nil {
+		return err
+	}
+
+	ruleSet.ID = 0
+	ruleSet.LeagueID = &leagueID
+	ruleSet.SeasonID = nil
+	return s.save(existing, ruleSet)
+}
+
+// SetSeasonRules creates or replaces the rules of a season, overriding its league rules
+func (s *RuleSetService) SetSeasonRules(seasonID uint, ruleSet *entities.RuleSet) error {
+	if seasonID == 0 {
+		return errors.New("invalid season ID")
+	}
+
+	existing, err := s.ruleSetRepo.FindBySeasonID(seasonID)
+	if err != nil {
+		return err
+	}
+
+	ruleSet.ID = 0
+	ruleSet.LeagueID = nil
+	ruleSet.SeasonID = &seasonID
+	return s.save(existing, ruleSet)
+}
+
+// DeleteSeasonRules removes the rules of a season so that its league rules apply again
+func (s *RuleSetService) DeleteSeasonRules(seasonID uint) error {
+	if seasonID == 0 {
+		return errors.New("invalid season ID")
+	}
+
+	existing, err := s.ruleSetRepo.FindBySeasonID(seasonID)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return errors.New("season has no rules of its own")
+	}
+
+	return s.ruleSetRepo.Delete(existing.ID)
+}
+
+// save validates a rule set and stores it in place of the existing one, if any
+func (s *RuleSetService) save(existing, ruleSet *entities.RuleSet) error {
+	if err := validateRuleSet(ruleSet); err != nil {
+		return err
+	}
+
+	if existing != nil {
+		ruleSet.ID = existing.ID
+		ruleSet.CreatedAt = existing.CreatedAt
+	}
+
+	return s.ruleSetRepo.Save(ruleSet)
+}
+
+// validateRuleSet checks that points are sensible and tiebreakers are known and unique
+func validateRuleSet(ruleSet *entities.RuleSet) error {
+	if ruleSet.PointsForWin < 0 || ruleSet.PointsForDraw < 0 || ruleSet.PointsForLoss < 0 {
+		return errors.New("points cannot be negative")
+	}
+
+	if ruleSet.PointsForWin < ruleSet.PointsForDraw || ruleSet.PointsForDraw < ruleSet.PointsForLoss {
+		return errors.New("points for a win must be at least those for a draw, and a draw at least those for a loss")
+	}
+
+	if ruleSet.YellowCardThreshold != nil && *ruleSet.YellowCardThreshold < 1 {
+		return errors.New("yellow card threshold must be at least 1")
+	}
+
+	if (ruleSet.YellowCardBanMatches != nil && *ruleSet.YellowCardBanMatches < 0) ||
+		(ruleSet.RedCardBanMatches != nil && *ruleSet.RedCardBanMatches < 0) {
+		return errors.New("bans cannot be negative")
+	}
+
+	if ruleSet.AwardedGoals != nil && *ruleSet.AwardedGoals < 0 {
+		return errors.New("awarded goals cannot be negative")
+	}
+
+	seen := make(map[entities.Tiebreaker]bool)
+	for _, tiebreaker := range ruleSet.Tiebreakers {
+		switch tiebreaker {
+		case entities.TiebreakerHeadToHead, entities.TiebreakerGoalDifference, entities.TiebreakerGoalsFor,
+			entities.TiebreakerAwayGoals, entities.TiebreakerWins, entities.TiebreakerFairPlay:
+		default:
+			return fmt.Errorf("unknown tiebreaker %q", tiebreaker)
+		}
+
+		if seen[tiebreaker] {
+			return fmt.Errorf("tiebreaker %q is listed more than once", tiebreaker)
+		}
+		seen[tiebreaker] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newTestRuleSetService creates a rule set service with season 1 of league 1 already stored
+func newTestRuleSetService() (*RuleSetService, *MockRuleSetRepository, *MockSeasonRepository) {
+	ruleSetRepo := NewMockRuleSetRepository()
+	seasonRepo := NewMockSeasonRepository()
+	seasonRepo.Create(&entities.Season{
+		ID:       1,
+		LeagueID: 1,
+		Name:     "2025",
+		StartsAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndsAt:   time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+	})
+	return NewRuleSetService(ruleSetRepo, seasonRepo), ruleSetRepo, seasonRepo
+}
+
+// TestRuleSetService_ResolveForSeason tests the season, league and default fallback order
+func TestRuleSetService_ResolveForSeason(t *testing.T) {
+	service, _, _ := newTestRuleSetService()
+
+	rules, err := service.ResolveForSeason(1)
+	if err != nil {
+		t.Fatalf("ResolveForSeason() error = %v", err)
+	}
+	if !reflect.DeepEqual(rules, entities.DefaultRuleSet()) {
+		t.Errorf("ResolveForSeason() = %+v, want the default rules", rules)
+	}
+
+	leagueRules := &entities.RuleSet{PointsForWin: 2, PointsForDraw: 1, Tiebreakers: []entities.Tiebreaker{entities.TiebreakerHeadToHead}}
+	if err := service.SetLeagueRules(1, leagueRules); err != nil {
+		t.Fatalf("SetLeagueRules() error = %v", err)
+	}
+	rules, _ = service.ResolveForSeason(1)
+	if rules.PointsForWin != 2 {
+		t.Errorf("season should inherit league rules, got %d points for a win", rules.PointsForWin)
+	}
+
+	seasonRules := &entities.RuleSet{PointsForWin: 4, PointsForDraw: 2, PointsForLoss: 1}
+	if err := service.SetSeasonRules(1, seasonRules); err != nil {
+		t.Fatalf("SetSeasonRules() error = %v", err)
+	}
+	rules, _ = service.ResolveForSeason(1)
+	if rules.PointsForWin != 4 || rules.PointsForLoss != 1 {
+		t.Errorf("season rules should override league rules, got %+v", rules)
+	}
+
+	if err := service.DeleteSeasonRules(1); err != nil {
+		t.Fatalf("DeleteSeasonRules() error = %v", err)
+	}
+	rules, _ = service.ResolveForSeason(1)
+	if rules.PointsForWin != 2 {
+		t.Errorf("league rules should apply again, got %d points for a win", rules.PointsForWin)
+	}
+}
+
+// TestRuleSetService_SetLeagueRules tests rule set validation
+func TestRuleSetService_SetLeagueRules(t *testing.T) {
+	service, _, _ := newTestRuleSetService()
+
+	tests := []struct {
+		name    string
+		ruleSet *entities.RuleSet
+		wantErr bool
+	}{
+		{
+			name:    "Valid rules",
+			ruleSet: &entities.RuleSet{PointsForWin: 2, PointsForDraw: 1, Tiebreakers: []entities.Tiebreaker{entities.TiebreakerHeadToHead, entities.TiebreakerGoalDifference}},
+			wantErr: false,
+		},
+		{
+			name:    "Negative points",
+			ruleSet: &entities.RuleSet{PointsForWin: 3, PointsForDraw: 1, PointsForLoss: -1},
+			wantErr: true,
+		},
+		{
+			name:    "Draw worth more than a win",
+			ruleSet: &entities.RuleSet{PointsForWin: 1, PointsForDraw: 2},
+			wantErr: true,
+		},
+		{
+			name:    "Unknown tiebreaker",
+			ruleSet: &entities.RuleSet{PointsForWin: 3, PointsForDraw: 1, Tiebreakers: []entities.Tiebreaker{"coin_toss"}},
+			wantErr: true,
+		},
+		{
+			name:    "No red card ban",
+			ruleSet: &entities.RuleSet{PointsForWin: 3, PointsForDraw: 1, RedCardBanMatches: new(int)},
+			wantErr: false,
+		},
+		{
+			name:    "Zero yellow card threshold",
+			ruleSet: &entities.RuleSet{PointsForWin: 3, PointsForDraw: 1, YellowCardThreshold: new(int)},
+			wantErr: true,
+		},
+		{
+			name:    "Duplicated tiebreaker",
+			ruleSet: &entities.RuleSet{PointsForWin: 3, PointsForDraw: 1, Tiebreakers: []entities.Tiebreaker{entities.TiebreakerGoalsFor, entities.TiebreakerGoalsFor}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := service.SetLeagueRules(1, tt.ruleSet)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SetLeagueRules() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"sort"
+)
+
+// MockSeasonRepository is an in-memory implementation of SeasonRepository for testing.
+// CreateWithSetup stores rule sets and matches in the linked repositories when they are set.
+type MockSeasonRepository struct {
+	seasons     map[uint]*entities.Season
+	nextID      uint
+	ruleSetRepo *MockRuleSetRepository
+	matchRepo   *MockMatchRepository
+}
+
+// NewMockSeasonRepository creates a new mock season repository
+func NewMockSeasonRepository() *MockSeasonRepository {
+	return &MockSeasonRepository{
+		seasons: make(map[uint]*entities.Season),
+		nextID:  1,
+	}
+}
+
+func (m *MockSeasonRepository) Create(season *entities.Season) error {
+	if season.ID == 0 {
+		season.ID = m.nextID
+	}
+	if season.ID >= m.nextID {
+		m.nextID = season.ID + 1
+	}
+	stored := *season
+	m.seasons[season.ID] = &stored
+	return nil
+}
+
+func (m *MockSeasonRepository) GetByID(id uint) (*entities.Season, error) {
+	if season, exists := m.seasons[id]; exists {
+		found := *season
+		return &found, nil
+	}
+	return nil, errors.New("record not found")
+}
+
+func (m *MockSeasonRepository) GetAll() ([]entities.Season, error) {
+	return m.filter(func(*entities.Season) bool { return true }), nil
+}
+
+func (m *MockSeasonRepository) GetAllWithTeams() ([]entities.Season, error) {
+	return m.GetAll()
+}
+
+func (m *MockSeasonRepository) Update(season *entities.Season) error {
+	if _, exists := m.seasons[season.ID]; !exists {
+		return errors.New("record not found")
+	}
+	stored := *season
+	m.seasons[season.ID] = &stored
+	return nil
+}
+
+func (m *MockSeasonRepository) Delete(id uint) error {
+	delete(m.seasons, id)
+	return nil
+}
+
+func (m *MockSeasonRepository) GetWithLeague(id uint) (*entities.Season, error) {
+	return m.GetByID(id)
+}
+
+func (m *MockSeasonRepository) GetWithTeams(id uint) (*entities.Season, error) {
+	return m.GetByID(id)
+}
+
+func (m *MockSeasonRepository) GetWithMatches(id uint) (*entities.Season, error) {
+	return m.GetByID(id)
+}
+
+func (m *MockSeasonRepository) GetActiveSeasons() ([]entities.Season, error) {
+	return m.filter(func(season *entities.Season) bool {
+		return season.Status == entities.SeasonStatusActive
+	}), nil
+}
+
+func (m *MockSeasonRepository) GetByLeagueID(leagueID uint) ([]entities.Season, error) {
+	return m.filter(func(season *entities.Season) bool { return season.LeagueID == leagueID }), nil
+}
+
+// filter returns copies of the stored seasons that satisfy keep, ordered by ID
+func (m *MockSeasonRepository) filter(keep func(*entities.Season) bool) []entities.Season {
+	seasons := make([]entities.Season, 0, len(m.seasons))
+	for _, season := range m.seasons {
+		if keep(season) {
+			seasons = append(seasons, *season)
+		}
+	}
+	sort.Slice(seasons, func(i, j int) bool { return seasons[i].ID < seasons[j].ID })
+	return seasons
+}
+
+func (m *MockSeasonRepository) AddTeam(seasonID uint, teamID uint) error {
+	season, exists := m.seasons[seasonID]
+	if !exists {
+		return errors.New("record not found")
+	}
+	season.Teams = append(season.Teams, entities.Team{ID: teamID, Name: teamName(teamID)})
+	return nil
+}
+
+func (m *MockSeasonRepository) RemoveTeam(seasonID uint, teamID uint) error {
+	season, exists := m.seasons[seasonID]
+	if !exists {
+		return errors.New("record not found")
+	}
+	teams := make([]entities.Team, 0, len(season.Teams))
+	for _, team := range season.Teams {
+		if team.ID != teamID {
+			teams = append(teams, team)
+		}
+	}
+	season.Teams = teams
+	return nil
+}
+
+func (m *MockSeasonRepository) ReplaceTeams(seasonID uint, teamIDs []uint) error {
+	season, exists := m.seasons[seasonID]
+	if !exists {
+		return errors.New("record not found")
+	}
+	season.Teams = make([]entities.Team, 0, len(teamIDs))
+	for _, teamID := range teamIDs {
+		season.Teams = append(season.Teams, entities.Team{ID: teamID, Name: teamName(teamID)})
+	}
+	return nil
+}
+
+func (m *MockSeasonRepository) CreateWithSetup(season *entities.Season, ruleSet *entities.RuleSet, matches []entities.Match) error {
+	if err := m.Create(season); err != nil {
+		return err
+	}
+	if ruleSet != nil && m.ruleSetRepo != nil {
+		ruleSet.SeasonID = &season.ID
+		m.ruleSetRepo.Save(ruleSet)
+	}
+	for i := range matches {
+		matches[i].SeasonID = season.ID
+	}
+	if m.matchRepo != nil {
+		return m.matchRepo.CreateBatch(matches)
+	}
+	return nil
+}
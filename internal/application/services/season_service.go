@@ -4,20 +4,65 @@ import (
 	"catalyst-players/internal/domain/entities"
 	"catalyst-players/internal/domain/repositories"
 	"errors"
+	"fmt"
+	"sort"
+	"time"
 )
 
+// RolloverOptions holds the parameters used to clone a season into the next one.
+// Zero dates shift the dates of the source season by one year. Incoming teams (promoted or
+// relegated into the league) are enrolled and outgoing teams are left out. ApplyMovements adds
+// the promotions and relegations computed when the source season and the concurrent season of
+// the linked leagues were completed.
+type RolloverOptions struct {
+	Name             string    `json:"name"`
+	StartsAt         time.Time `json:"starts_at"`
+	EndsAt           time.Time `json:"ends_at"`
+	IncomingTeamIDs  []uint    `json:"incoming_team_ids"`
+	OutgoingTeamIDs  []uint    `json:"outgoing_team_ids"`
+	ApplyMovements   bool      `json:"apply_movements"`
+	GenerateFixtures bool      `json:"generate_fixtures"`
+	DoubleRoundRobin bool      `json:"double_round_robin"`
+	StadiumID        uint      `json:"stadium_id"`
+}
+
+// SeasonActivatedHook is called after a season has been activated
+type SeasonActivatedHook func(season *entities.Season) error
+
+// SeasonCompletedHook is called when a season is completed, before the new status is saved
+type SeasonCompletedHook func(season *entities.Season) error
+
 // SeasonService handles business logic for season operations
 type SeasonService struct {
-	seasonRepo repositories.SeasonRepository
+	seasonRepo     repositories.SeasonRepository
+	ruleSetRepo    repositories.RuleSetRepository
+	matchRepo      repositories.MatchRepository
+	movementRepo   repositories.TeamMovementRepository
+	activatedHooks []SeasonActivatedHook
+	completedHooks []SeasonCompletedHook
 }
 
 // NewSeasonService creates a new season service instance
-func NewSeasonService(seasonRepo repositories.SeasonRepository) *SeasonService {
+func NewSeasonService(seasonRepo repositories.SeasonRepository, ruleSetRepo repositories.RuleSetRepository, matchRepo repositories.MatchRepository, movementRepo repositories.TeamMovementRepository) *SeasonService {
 	return &SeasonService{
-		seasonRepo: seasonRepo,
+		seasonRepo:   seasonRepo,
+		ruleSetRepo:  ruleSetRepo,
+		matchRepo:    matchRepo,
+		movementRepo: movementRepo,
 	}
 }
 
+// OnSeasonActivated registers a hook that runs whenever a season is activated
+func (s *SeasonService) OnSeasonActivated(hook SeasonActivatedHook) {
+	s.activatedHooks = append(s.activatedHooks, hook)
+}
+
+// OnSeasonCompleted registers a hook that runs whenever a season is completed.
+// A hook error keeps the season open.
+func (s *SeasonService) OnSeasonCompleted(hook SeasonCompletedHook) {
+	s.completedHooks = append(s.completedHooks, hook)
+}
+
 // CreateSeason creates a new season
 func (s *SeasonService) CreateSeason(season *entities.Season) error {
 	if season.Name == "" {
@@ -124,7 +169,16 @@ func (s *SeasonService) ActivateSeason(id uint) error {
 	}
 
 	season.Status = entities.SeasonStatusActive
-	return s.seasonRepo.Update(season)
+	if err := s.seasonRepo.Update(season); err != nil {
+		return err
+	}
+
+	for _, hook := range s.activatedHooks {
+		if err := hook(season); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // CompleteSeason completes a season
@@ -135,5 +189,197 @@ func (s *SeasonService) CompleteSeason(id uint) error {
 	}
 
 	season.Status = entities.SeasonStatusCompleted
+	for _, hook := range s.completedHooks {
+		if err := hook(season); err != nil {
+			return err
+		}
+	}
+
 	return s.seasonRepo.Update(season)
 }
+
+// RolloverSeason creates a new draft season in the same league as the given one, with the same
+// teams apart from the promotion and relegation changes, a copy of the season's own rules and,
+// optionally, a freshly generated round-robin schedule. Home matches are played at the stadium
+// each team last hosted in the source season, falling back to the stadium of the options.
+// Everything is stored in a single transaction.
+func (s *SeasonService) RolloverSeason(id uint, opts RolloverOptions) (*entities.Season, []entities.Match, error) {
+	if id == 0 {
+		return nil, nil, errors.New("invalid season ID")
+	}
+
+	if opts.Name == "" {
+		return nil, nil, errors.New("season name is required")
+	}
+
+	source, err := s.seasonRepo.GetWithTeams(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	next := &entities.Season{
+		LeagueID: source.LeagueID,
+		Name:     opts.Name,
+		StartsAt: opts.StartsAt,
+		EndsAt:   opts.EndsAt,
+		Status:   entities.SeasonStatusDraft,
+	}
+	if next.StartsAt.IsZero() {
+		next.StartsAt = source.StartsAt.AddDate(1, 0, 0)
+	}
+	if next.EndsAt.IsZero() {
+		next.EndsAt = source.EndsAt.AddDate(1, 0, 0)
+	}
+	if next.StartsAt.After(next.EndsAt) {
+		return nil, nil, errors.New("start date must be before end date")
+	}
+
+	if opts.ApplyMovements {
+		if err := s.addMovements(source, &opts); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	teamIDs, err := rolloverTeams(source, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, teamID := range teamIDs {
+		next.Teams = append(next.Teams, entities.Team{ID: teamID})
+	}
+
+	ruleSet, err := s.ruleSetRepo.FindBySeasonID(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ruleSet != nil {
+		copied := *ruleSet
+		copied.ID = 0
+		copied.SeasonID = nil
+		copied.CreatedAt = time.Time{}
+		copied.UpdatedAt = time.Time{}
+		ruleSet = &copied
+	}
+
+	matches := make([]entities.Match, 0)
+	if opts.GenerateFixtures {
+		matches, err = s.rolloverFixtures(source, next, teamIDs, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := s.seasonRepo.CreateWithSetup(next, ruleSet, matches); err != nil {
+		return nil, nil, err
+	}
+
+	created, err := s.seasonRepo.GetWithTeams(next.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return created, matches, nil
+}
+
+// addMovements adds the teams leaving the league of the source season to the outgoing teams,
+// and the teams moving into it from seasons of the linked leagues played at the same time to
+// the incoming teams
+func (s *SeasonService) addMovements(source *entities.Season, opts *RolloverOptions) error {
+	outgoing, err := s.movementRepo.GetBySeasonID(source.ID)
+	if err != nil {
+		return err
+	}
+	for _, movement := range outgoing {
+		opts.OutgoingTeamIDs = appendMissing(opts.OutgoingTeamIDs, movement.TeamID)
+	}
+
+	incoming, err := s.movementRepo.GetByToLeagueID(source.LeagueID)
+	if err != nil {
+		return err
+	}
+	for _, movement := range incoming {
+		from, err := s.seasonRepo.GetByID(movement.SeasonID)
+		if err != nil {
+			return err
+		}
+		if from.StartsAt.Before(source.EndsAt) && from.EndsAt.After(source.StartsAt) {
+			opts.IncomingTeamIDs = appendMissing(opts.IncomingTeamIDs, movement.TeamID)
+		}
+	}
+	return nil
+}
+
+// appendMissing appends an ID to a list unless it is already in it
+func appendMissing(ids []uint, id uint) []uint {
+	for _, existing := range ids {
+		if existing == id {
+			return ids
+		}
+	}
+	return append(ids, id)
+}
+
+// rolloverFixtures schedules the next season with the home stadiums of the source season
+func (s *SeasonService) rolloverFixtures(source, next *entities.Season, teamIDs []uint, opts RolloverOptions) ([]entities.Match, error) {
+	if len(teamIDs) < 2 {
+		return nil, errors.New("season needs at least two enrolled teams")
+	}
+
+	previous, err := s.matchRepo.GetBySeasonID(source.ID)
+	if err != nil {
+		return nil, err
+	}
+	sortMatchesChronologically(previous)
+
+	homeStadiums := make(map[uint]uint)
+	for _, match := range previous {
+		if match.StadiumID != 0 {
+			homeStadiums[match.HomeTeamID] = match.StadiumID
+		}
+	}
+
+	for _, teamID := range teamIDs {
+		if homeStadiums[teamID] == 0 && opts.StadiumID == 0 {
+			return nil, fmt.Errorf("stadium ID is required: team %d hosted no match in the previous season", teamID)
+		}
+	}
+
+	return scheduleRoundRobin(next, teamIDs, opts.DoubleRoundRobin, func(homeTeamID uint) uint {
+		if stadiumID := homeStadiums[homeTeamID]; stadiumID != 0 {
+			return stadiumID
+		}
+		return opts.StadiumID
+	}), nil
+}
+
+// rolloverTeams returns the sorted teams of the next season: those of the source season
+// without the outgoing teams, plus the incoming teams
+func rolloverTeams(source *entities.Season, opts RolloverOptions) ([]uint, error) {
+	enrolled := make(map[uint]bool)
+	for _, team := range source.Teams {
+		enrolled[team.ID] = true
+	}
+
+	for _, teamID := range opts.OutgoingTeamIDs {
+		if !enrolled[teamID] {
+			return nil, fmt.Errorf("outgoing team %d is not enrolled in the season", teamID)
+		}
+		delete(enrolled, teamID)
+	}
+
+	for _, teamID := range opts.IncomingTeamIDs {
+		if teamID == 0 {
+			return nil, errors.New("invalid incoming team ID")
+		}
+		if enrolled[teamID] {
+			return nil, fmt.Errorf("incoming team %d is already enrolled in the season", teamID)
+		}
+		enrolled[teamID] = true
+	}
+
+	teamIDs := make([]uint, 0, len(enrolled))
+	for teamID := range enrolled {
+		teamIDs = append(teamIDs, teamID)
+	}
+	sort.Slice(teamIDs, func(i, j int) bool { return teamIDs[i] < teamIDs[j] })
+	return teamIDs, nil
+}
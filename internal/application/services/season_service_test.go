@@ -0,0 +1,106 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"testing"
+	"time"
+)
+
+// newTestSeasonService creates a season service whose season repository stores rollovers
+// in the returned rule set and match repositories
+func newTestSeasonService() (*SeasonService, *RuleSetService, *MockSeasonRepository, *MockMatchRepository) {
+	ruleSetService, ruleSetRepo, seasonRepo := newTestRuleSetService()
+	matchRepo := NewMockMatchRepository()
+	seasonRepo.ruleSetRepo = ruleSetRepo
+	seasonRepo.matchRepo = matchRepo
+	return NewSeasonService(seasonRepo, ruleSetRepo, matchRepo, NewMockTeamMovementRepository()), ruleSetService, seasonRepo, matchRepo
+}
+
+// TestSeasonService_RolloverSeason tests that a rollover copies teams with promotion and
+// relegation applied, carries the season rules over and schedules home matches at the
+// stadiums used in the previous season
+func TestSeasonService_RolloverSeason(t *testing.T) {
+	service, ruleSetService, seasonRepo, matchRepo := newTestSeasonService()
+	enrollTeams(seasonRepo, 1, 1, 2, 3, 4)
+	rules := entities.DefaultRuleSet()
+	rules.PointsForWin = 2
+	if err := ruleSetService.SetSeasonRules(1, rules); err != nil {
+		t.Fatalf("SetSeasonRules() error = %v", err)
+	}
+	for _, homeID := range []uint{1, 2, 3, 4} {
+		match := newFinishedMatch(1, homeID, homeID%4+1, 1, 0)
+		match.StadiumID = 10 + homeID
+		matchRepo.Create(match)
+	}
+
+	season, matches, err := service.RolloverSeason(1, RolloverOptions{
+		Name:             "2026",
+		IncomingTeamIDs:  []uint{5},
+		OutgoingTeamIDs:  []uint{4},
+		GenerateFixtures: true,
+		StadiumID:        99,
+	})
+	if err != nil {
+		t.Fatalf("RolloverSeason() error = %v", err)
+	}
+
+	if season.ID == 1 || season.LeagueID != 1 || season.Status != entities.SeasonStatusDraft {
+		t.Errorf("new season = %+v, want a new draft season in league 1", season)
+	}
+	if want := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC); !season.StartsAt.Equal(want) {
+		t.Errorf("new season starts at %v, want %v", season.StartsAt, want)
+	}
+	if got := seasonTeamIDs(season); len(got) != 4 || got[0] != 1 || got[1] != 2 || got[2] != 3 || got[3] != 5 {
+		t.Errorf("new season teams = %v, want [1 2 3 5]", got)
+	}
+
+	copied, err := ruleSetService.ResolveForSeason(season.ID)
+	if err != nil {
+		t.Fatalf("ResolveForSeason() error = %v", err)
+	}
+	if copied.PointsForWin != 2 || copied.SeasonID == nil || *copied.SeasonID != season.ID {
+		t.Errorf("rules of the new season = %+v, want a copy with 2 points for a win", copied)
+	}
+
+	if len(matches) != 6 {
+		t.Fatalf("got %d fixtures, want 6", len(matches))
+	}
+	for _, match := range matches {
+		want := uint(10) + match.HomeTeamID
+		if match.HomeTeamID == 5 {
+			want = 99
+		}
+		if match.SeasonID != season.ID || match.StadiumID != want {
+			t.Errorf("match %d-%d is in season %d at stadium %d, want season %d at stadium %d",
+				match.HomeTeamID, match.AwayTeamID, match.SeasonID, match.StadiumID, season.ID, want)
+		}
+	}
+	if stored, _ := matchRepo.GetBySeasonID(season.ID); len(stored) != 6 {
+		t.Errorf("%d fixtures were stored, want 6", len(stored))
+	}
+}
+
+// TestSeasonService_RolloverSeasonValidation tests that invalid rollovers store nothing
+func TestSeasonService_RolloverSeasonValidation(t *testing.T) {
+	service, _, seasonRepo, _ := newTestSeasonService()
+	enrollTeams(seasonRepo, 1, 1, 2)
+
+	tests := []struct {
+		name string
+		opts RolloverOptions
+	}{
+		{name: "missing name", opts: RolloverOptions{}},
+		{name: "outgoing team not enrolled", opts: RolloverOptions{Name: "2026", OutgoingTeamIDs: []uint{7}}},
+		{name: "incoming team already enrolled", opts: RolloverOptions{Name: "2026", IncomingTeamIDs: []uint{2}}},
+		{name: "fixtures without stadiums", opts: RolloverOptions{Name: "2026", GenerateFixtures: true}},
+	}
+
+	for _, tt := range tests {
+		if _, _, err := service.RolloverSeason(1, tt.opts); err == nil {
+			t.Errorf("RolloverSeason() with %s should fail", tt.name)
+		}
+	}
+	if seasons, _ := seasonRepo.GetAll(); len(seasons) != 1 {
+		t.Errorf("got %d seasons after failed rollovers, want 1", len(seasons))
+	}
+}
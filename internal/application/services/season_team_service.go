@@ -0,0 +1,158 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"errors"
+	"fmt"
+)
+
+// Errors returned when the teams of a season cannot be changed
+var (
+	ErrSeasonTeamsLocked = errors.New("teams of a season that is not a draft can only be changed with force")
+	ErrTeamHasMatches    = errors.New("team already has matches in this season")
+)
+
+// SeasonTeamService manages the teams enrolled in a season through season_team
+type SeasonTeamService struct {
+	seasonRepo repositories.SeasonRepository
+	teamRepo   repositories.TeamRepository
+	matchRepo  repositories.MatchRepository
+}
+
+// NewSeasonTeamService creates a new season team service instance
+func NewSeasonTeamService(seasonRepo repositories.SeasonRepository, teamRepo repositories.TeamRepository, matchRepo repositories.MatchRepository) *SeasonTeamService {
+	return &SeasonTeamService{
+		seasonRepo: seasonRepo,
+		teamRepo:   teamRepo,
+		matchRepo:  matchRepo,
+	}
+}
+
+// AddTeam enrolls a team in a season and returns the season with its teams
+func (s *SeasonTeamService) AddTeam(seasonID, teamID uint, force bool) (*entities.Season, error) {
+	season, err := s.editableSeason(seasonID, force)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkTeamExists(teamID); err != nil {
+		return nil, err
+	}
+
+	if seasonHasTeam(season, teamID) {
+		return nil, errors.New("team is already enrolled in this season")
+	}
+
+	if err := s.seasonRepo.AddTeam(seasonID, teamID); err != nil {
+		return nil, err
+	}
+
+	return s.seasonRepo.GetWithTeams(seasonID)
+}
+
+// RemoveTeam withdraws a team from a season. Teams that already have matches in the season
+// cannot be removed, even with force.
+func (s *SeasonTeamService) RemoveTeam(seasonID, teamID uint, force bool) error {
+	season, err := s.editableSeason(seasonID, force)
+	if err != nil {
+		return err
+	}
+
+	if !seasonHasTeam(season, teamID) {
+		return errors.New("team is not enrolled in this season")
+	}
+
+	scheduled, err := s.teamsWithMatches(seasonID)
+	if err != nil {
+		return err
+	}
+	if scheduled[teamID] {
+		return fmt.Errorf("%w: team %d", ErrTeamHasMatches, teamID)
+	}
+
+	return s.seasonRepo.RemoveTeam(seasonID, teamID)
+}
+
+// ReplaceTeams sets the teams enrolled in a season and returns the season with its teams.
+// None of the teams dropped from the season may have matches in it.
+func (s *SeasonTeamService) ReplaceTeams(seasonID uint, teamIDs []uint, force bool) (*entities.Season, error) {
+	season, err := s.editableSeason(seasonID, force)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[uint]bool)
+	unique := make([]uint, 0, len(teamIDs))
+	for _, teamID := range teamIDs {
+		if wanted[teamID] {
+			continue
+		}
+		if err := s.checkTeamExists(teamID); err != nil {
+			return nil, err
+		}
+		wanted[teamID] = true
+		unique = append(unique, teamID)
+	}
+
+	scheduled, err := s.teamsWithMatches(seasonID)
+	if err != nil {
+		return nil, err
+	}
+	for _, team := range season.Teams {
+		if !wanted[team.ID] && scheduled[team.ID] {
+			return nil, fmt.Errorf("%w: team %d", ErrTeamHasMatches, team.ID)
+		}
+	}
+
+	if err := s.seasonRepo.ReplaceTeams(seasonID, unique); err != nil {
+		return nil, err
+	}
+
+	return s.seasonRepo.GetWithTeams(seasonID)
+}
+
+// editableSeason loads a season with its teams and checks that its teams may be changed
+func (s *SeasonTeamService) editableSeason(seasonID uint, force bool) (*entities.Season, error) {
+	if seasonID == 0 {
+		return nil, errors.New("invalid season ID")
+	}
+
+	season, err := s.seasonRepo.GetWithTeams(seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	if season.Status != entities.SeasonStatusDraft && !force {
+		return nil, ErrSeasonTeamsLocked
+	}
+
+	return season, nil
+}
+
+// checkTeamExists returns an error unless the team exists
+func (s *SeasonTeamService) checkTeamExists(teamID uint) error {
+	if teamID == 0 {
+		return errors.New("invalid team ID")
+	}
+
+	if _, err := s.teamRepo.GetByID(teamID); err != nil {
+		return fmt.Errorf("team %d not found", teamID)
+	}
+	return nil
+}
+
+// teamsWithMatches returns the teams that play at least one match of the season
+func (s *SeasonTeamService) teamsWithMatches(seasonID uint) (map[uint]bool, error) {
+	matches, err := s.matchRepo.GetBySeasonID(seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := make(map[uint]bool)
+	for _, match := range matches {
+		teams[match.HomeTeamID] = true
+		teams[match.AwayTeamID] = true
+	}
+	return teams, nil
+}
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"testing"
+)
+
+// newTestSeasonTeamService creates a season team service for draft season 1 with teams 1 to 5
+func newTestSeasonTeamService() (*SeasonTeamService, *MockSeasonRepository, *MockMatchRepository) {
+	_, _, seasonRepo := newTestRuleSetService()
+	matchRepo := NewMockMatchRepository()
+	return NewSeasonTeamService(seasonRepo, NewMockTeamRepository(1, 2, 3, 4, 5), matchRepo), seasonRepo, matchRepo
+}
+
+// seasonTeamIDs returns the IDs of the teams enrolled in a season
+func seasonTeamIDs(season *entities.Season) []uint {
+	ids := make([]uint, 0, len(season.Teams))
+	for _, team := range season.Teams {
+		ids = append(ids, team.ID)
+	}
+	return ids
+}
+
+// TestSeasonTeamService_Enrollment tests adding, replacing and removing teams of a draft season
+func TestSeasonTeamService_Enrollment(t *testing.T) {
+	service, _, _ := newTestSeasonTeamService()
+
+	for _, teamID := range []uint{1, 2} {
+		if _, err := service.AddTeam(1, teamID, false); err != nil {
+			t.Fatalf("AddTeam(%d) error = %v", teamID, err)
+		}
+	}
+	if _, err := service.AddTeam(1, 2, false); err == nil {
+		t.Errorf("AddTeam() for an enrolled team should fail")
+	}
+	if _, err := service.AddTeam(1, 9, false); err == nil {
+		t.Errorf("AddTeam() for an unknown team should fail")
+	}
+
+	season, err := service.ReplaceTeams(1, []uint{2, 3, 4, 3}, false)
+	if err != nil {
+		t.Fatalf("ReplaceTeams() error = %v", err)
+	}
+	if got := seasonTeamIDs(season); len(got) != 3 || got[0] != 2 || got[1] != 3 || got[2] != 4 {
+		t.Errorf("teams after ReplaceTeams() = %v, want [2 3 4]", got)
+	}
+
+	if err := service.RemoveTeam(1, 3, false); err != nil {
+		t.Fatalf("RemoveTeam() error = %v", err)
+	}
+	if err := service.RemoveTeam(1, 3, false); err == nil {
+		t.Errorf("RemoveTeam() for a team that is not enrolled should fail")
+	}
+}
+
+// TestSeasonTeamService_Rules tests that active seasons need force and that teams with matches stay
+func TestSeasonTeamService_Rules(t *testing.T) {
+	service, seasonRepo, matchRepo := newTestSeasonTeamService()
+	service.ReplaceTeams(1, []uint{1, 2, 3}, false)
+	matchRepo.Create(newFinishedMatch(1, 1, 2, 1, 0))
+
+	season, _ := seasonRepo.GetByID(1)
+	season.Status = entities.SeasonStatusActive
+	seasonRepo.Update(season)
+
+	if _, err := service.AddTeam(1, 4, false); !errors.Is(err, ErrSeasonTeamsLocked) {
+		t.Errorf("AddTeam() on an active season error = %v, want ErrSeasonTeamsLocked", err)
+	}
+	if _, err := service.AddTeam(1, 4, true); err != nil {
+		t.Errorf("AddTeam() with force error = %v", err)
+	}
+
+	if err := service.RemoveTeam(1, 1, true); !errors.Is(err, ErrTeamHasMatches) {
+		t.Errorf("RemoveTeam() for a team with matches error = %v, want ErrTeamHasMatches", err)
+	}
+	if _, err := service.ReplaceTeams(1, []uint{1, 3}, true); !errors.Is(err, ErrTeamHasMatches) {
+		t.Errorf("ReplaceTeams() dropping a team with matches error = %v, want ErrTeamHasMatches", err)
+	}
+	if err := service.RemoveTeam(1, 3, true); err != nil {
+		t.Errorf("RemoveTeam() for a team without matches error = %v", err)
+	}
+}
@@ -0,0 +1,283 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultSimulationRuns is the number of times a season is played out when none is given
+	DefaultSimulationRuns = 10000
+	// MaxSimulationRuns bounds the number of times a season can be played out in one request
+	MaxSimulationRuns = 100000
+	// simulationBatchRuns is the number of runs played with the same random number generator.
+	// Batches are seeded by their index, so results do not depend on the number of workers.
+	simulationBatchRuns = 250
+)
+
+// SimulationOptions holds the parameters of a season simulation
+type SimulationOptions struct {
+	// Runs is the number of times the season is played out; zero plays the default number
+	Runs int
+	// RandomSeed makes the simulation reproducible; zero simulates with the current time
+	RandomSeed int64
+	// Workers is the number of goroutines playing out the season; zero uses one per CPU
+	Workers int
+}
+
+// SimulationService estimates how a season will end by playing out its remaining matches
+// many times, with scores drawn from the Poisson model fitted to the completed matches
+type SimulationService struct {
+	leaderboardService *LeaderboardService
+	matchRepo          repositories.MatchRepository
+}
+
+// NewSimulationService creates a new simulation service instance
+func NewSimulationService(leaderboardService *LeaderboardService, matchRepo repositories.MatchRepository) *SimulationService {
+	return &SimulationService{
+		leaderboardService: leaderboardService,
+		matchRepo:          matchRepo,
+	}
+}
+
+// SimulateSeason plays out the remaining league matches of a season and returns the
+// probability of every team finishing in each position of the final leaderboard, ordered by
+// expected points. Final leaderboards use the season rules, tiebreakers and sanctions.
+func (s *SimulationService) SimulateSeason(seasonID uint, opts SimulationOptions) (*entities.SeasonSimulation, error) {
+	if seasonID == 0 {
+		return nil, errors.New("invalid season ID")
+	}
+
+	if opts.Runs == 0 {
+		opts.Runs = DefaultSimulationRuns
+	}
+	if opts.Runs < 0 || opts.Runs > MaxSimulationRuns {
+		return nil, fmt.Errorf("number of runs must be between 1 and %d", MaxSimulationRuns)
+	}
+	if opts.RandomSeed == 0 {
+		opts.RandomSeed = time.Now().UnixNano()
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = runtime.NumCPU()
+	}
+
+	projection, err := s.leaderboardService.project(seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := s.matchRepo.GetBySeasonID(seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := make([]entities.Match, 0)
+	for _, match := range matches {
+		if stillToPlay(&match) && projection.counts(match) {
+			remaining = append(remaining, match)
+		}
+	}
+	sortMatchesChronologically(remaining)
+
+	played := make([]entities.Match, 0, len(projection.matches))
+	for i := range projection.matches {
+		if rateable(&projection.matches[i]) {
+			played = append(played, projection.matches[i])
+		}
+	}
+	strengths := fitTeamStrengths(played)
+	expected := make([][2]float64, len(remaining))
+	for i, match := range remaining {
+		expected[i][0], expected[i][1] = strengths.expectedGoals(match.HomeTeamID, match.AwayTeamID)
+	}
+
+	current := projection.leaderboard(nil)
+	simulation := newSeasonSimulation(seasonID, opts, current, remaining)
+	positions := make(map[uint]int, len(simulation.Teams))
+	for i, team := range simulation.Teams {
+		positions[team.TeamID] = i
+	}
+
+	tally := newSimulationTally(len(simulation.Teams))
+	batches := make(chan simulationBatch)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	for worker := 0; worker < opts.Workers; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			local := newSimulationTally(len(simulation.Teams))
+			for batch := range batches {
+				playBatch(projection, remaining, expected, positions, opts, batch, local)
+			}
+			mu.Lock()
+			tally.merge(local)
+			mu.Unlock()
+		}()
+	}
+	for batch, seed := range batchSeeds(opts.RandomSeed, (opts.Runs+simulationBatchRuns-1)/simulationBatchRuns) {
+		batches <- simulationBatch{index: batch, seed: seed}
+	}
+	close(batches)
+	wg.Wait()
+
+	tally.fill(simulation, opts.Runs)
+	return simulation, nil
+}
+
+// simulationBatch identifies a batch of runs and the seed of its random numbers
+type simulationBatch struct {
+	index int
+	seed  int64
+}
+
+// batchSeeds draws the seeds of the batches of a simulation in order from a generator seeded
+// with the simulation seed. Unlike offsets from the simulation seed, they are not shared by
+// the batches of simulations with neighbouring seeds.
+func batchSeeds(seed int64, batches int) []int64 {
+	rng := rand.New(rand.NewSource(seed))
+	seeds := make([]int64, batches)
+	for i := range seeds {
+		seeds[i] = rng.Int63()
+	}
+	return seeds
+}
+
+// playBatch plays out the remaining matches with their expected goals for one batch of runs
+// and counts the final position and points of every team
+func playBatch(projection *standingsProjection, remaining []entities.Match, expected [][2]float64, positions map[uint]int, opts SimulationOptions, batch simulationBatch, tally *simulationTally) {
+	rng := rand.New(rand.NewSource(batch.seed))
+
+	simulated := make([]entities.Match, len(remaining))
+	copy(simulated, remaining)
+	scores := make([]int, 2*len(remaining))
+
+	runs := simulationBatchRuns
+	if rest := opts.Runs - batch.index*simulationBatchRuns; rest < runs {
+		runs = rest
+	}
+	for run := 0; run < runs; run++ {
+		for i := range simulated {
+			scores[2*i] = poissonSample(rng, expected[i][0])
+			scores[2*i+1] = poissonSample(rng, expected[i][1])
+			simulated[i].HomeTeamScore = &scores[2*i]
+			simulated[i].AwayTeamScore = &scores[2*i+1]
+		}
+
+		for position, entry := range projection.leaderboard(simulated) {
+			team := positions[entry.TeamID]
+			tally.finishes[team][position]++
+			tally.points[team] += entry.Points
+		}
+	}
+}
+
+// simulationTally counts how often each team finished in each position, and its total points
+type simulationTally struct {
+	finishes [][]int
+	points   []int
+}
+
+// newSimulationTally creates an empty tally for the given number of teams
+func newSimulationTally(teams int) *simulationTally {
+	tally := &simulationTally{finishes: make([][]int, teams), points: make([]int, teams)}
+	for i := range tally.finishes {
+		tally.finishes[i] = make([]int, teams)
+	}
+	return tally
+}
+
+// merge adds the counts of another tally
+func (t *simulationTally) merge(other *simulationTally) {
+	for team := range t.finishes {
+		for position := range t.finishes[team] {
+			t.finishes[team][position] += other.finishes[team][position]
+		}
+		t.points[team] += other.points[team]
+	}
+}
+
+// fill turns the counts into the probabilities and expectations of a simulation and orders
+// its teams by expected points, then by expected position
+func (t *simulationTally) fill(simulation *entities.SeasonSimulation, runs int) {
+	for i := range simulation.Teams {
+		team := &simulation.Teams[i]
+		team.ExpectedPoints = float64(t.points[i]) / float64(runs)
+		team.PositionProbabilities = make([]float64, len(t.finishes[i]))
+		for position, count := range t.finishes[i] {
+			team.PositionProbabilities[position] = float64(count) / float64(runs)
+			team.ExpectedPosition += float64(position+1) * float64(count) / float64(runs)
+		}
+	}
+
+	sort.SliceStable(simulation.Teams, func(i, j int) bool {
+		a, b := simulation.Teams[i], simulation.Teams[j]
+		if a.ExpectedPoints != b.ExpectedPoints {
+			return a.ExpectedPoints > b.ExpectedPoints
+		}
+		return a.ExpectedPosition < b.ExpectedPosition
+	})
+}
+
+// newSeasonSimulation lists every team of the current leaderboard in its current position,
+// followed by the teams that have only matches still to play
+func newSeasonSimulation(seasonID uint, opts SimulationOptions, current entities.Leaderboard, remaining []entities.Match) *entities.SeasonSimulation {
+	simulation := &entities.SeasonSimulation{
+		SeasonID:         seasonID,
+		Runs:             opts.Runs,
+		Seed:             opts.RandomSeed,
+		RemainingMatches: len(remaining),
+		Teams:            make([]entities.TeamSimulation, 0, len(current)),
+	}
+
+	listed := make(map[uint]bool)
+	for i, entry := range current {
+		listed[entry.TeamID] = true
+		simulation.Teams = append(simulation.Teams, entities.TeamSimulation{
+			TeamID:          entry.TeamID,
+			TeamName:        entry.TeamName,
+			CurrentPosition: i + 1,
+			CurrentPoints:   entry.Points,
+		})
+	}
+	for _, match := range remaining {
+		for _, team := range []entities.Team{match.HomeTeam, match.AwayTeam} {
+			if !listed[team.ID] {
+				listed[team.ID] = true
+				simulation.Teams = append(simulation.Teams, entities.TeamSimulation{TeamID: team.ID, TeamName: team.Name})
+			}
+		}
+	}
+
+	return simulation
+}
+
+// stillToPlay reports whether a match has yet to be played to a result. Live matches are
+// played out from the start, as their final score is not known.
+func stillToPlay(match *entities.Match) bool {
+	switch matchStatus(match) {
+	case entities.MatchStatusScheduled, entities.MatchStatusPostponed, entities.MatchStatusInProgress, entities.MatchStatusHalfTime:
+		return true
+	default:
+		return false
+	}
+}
+
+// poissonSample draws a number of goals from a Poisson distribution with the given mean by
+// multiplying uniform draws until their product falls below e^-mean
+func poissonSample(rng *rand.Rand, mean float64) int {
+	limit := math.Exp(-mean)
+	goals := 0
+	for product := rng.Float64(); product > limit; product *= rng.Float64() {
+		goals++
+	}
+	return goals
+}
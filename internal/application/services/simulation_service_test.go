@@ -0,0 +1,166 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// newTestSimulationService creates a simulation service on a season where teams 1, 2 and 3
+// drew every match 1-1, so that every team is average and there is no home advantage
+func newTestSimulationService() (*SimulationService, *MockMatchRepository, *MockTeamSanctionRepository) {
+	matchRepo := NewMockMatchRepository()
+	sanctionRepo := NewMockTeamSanctionRepository()
+	ruleSetService, _, _ := newTestRuleSetService()
+	leaderboardService := NewLeaderboardService(matchRepo, NewMockMatchPlayerRepository(matchRepo), ruleSetService, sanctionRepo)
+
+	matchRepo.Create(newFinishedMatch(1, 1, 2, 1, 1))
+	matchRepo.Create(newFinishedMatch(1, 2, 3, 1, 1))
+	matchRepo.Create(newFinishedMatch(1, 3, 1, 1, 1))
+
+	return NewSimulationService(leaderboardService, matchRepo), matchRepo, sanctionRepo
+}
+
+// simulatedTeam returns the simulation of a team, failing the test when it is missing
+func simulatedTeam(t *testing.T, simulation *entities.SeasonSimulation, teamID uint) entities.TeamSimulation {
+	t.Helper()
+	for _, team := range simulation.Teams {
+		if team.TeamID == teamID {
+			return team
+		}
+	}
+	t.Fatalf("team %d is missing from the simulation", teamID)
+	return entities.TeamSimulation{}
+}
+
+// TestSimulationService_SimulateSeason tests the finishing probabilities against a known
+// fixture: a last match between two equal teams decides the title
+func TestSimulationService_SimulateSeason(t *testing.T) {
+	service, matchRepo, _ := newTestSimulationService()
+	matchRepo.Create(newScheduledMatch(1, 1, 2))
+
+	simulation, err := service.SimulateSeason(1, SimulationOptions{Runs: 20000, RandomSeed: 7})
+	if err != nil {
+		t.Fatalf("SimulateSeason() error = %v", err)
+	}
+	if simulation.RemainingMatches != 1 || simulation.Runs != 20000 || simulation.Seed != 7 {
+		t.Errorf("simulation = %+v, want 20000 runs of 1 remaining match with seed 7", simulation)
+	}
+
+	// Team A wins the title with a home win or a draw, which it takes on the team name;
+	// team C finishes second unless the match is drawn
+	tests := []struct {
+		teamID uint
+		want   []float64
+	}{
+		{teamID: 1, want: []float64{0.65425, 0, 0.34575}},
+		{teamID: 2, want: []float64{0.34575, 0.30851, 0.34575}},
+		{teamID: 3, want: []float64{0, 0.69149, 0.30851}},
+	}
+	for _, tt := range tests {
+		team := simulatedTeam(t, simulation, tt.teamID)
+		if team.CurrentPoints != 2 {
+			t.Errorf("team %d has %d current points, want 2", tt.teamID, team.CurrentPoints)
+		}
+		for position, want := range tt.want {
+			if got := team.PositionProbabilities[position]; math.Abs(got-want) > 0.015 {
+				t.Errorf("team %d finishes %d with probability %.4f, want %.4f", tt.teamID, position+1, got, want)
+			}
+		}
+	}
+	if team := simulatedTeam(t, simulation, 3); team.ExpectedPoints != 2 {
+		t.Errorf("team C expects %.2f points, want 2", team.ExpectedPoints)
+	}
+}
+
+// TestSimulationService_Deterministic tests that a seed gives the same simulation whatever
+// the number of goroutines playing it out
+func TestSimulationService_Deterministic(t *testing.T) {
+	service, matchRepo, _ := newTestSimulationService()
+	matchRepo.Create(newScheduledMatch(1, 2, 1))
+	matchRepo.Create(newScheduledMatch(1, 1, 3))
+	matchRepo.Create(newScheduledMatch(1, 3, 2))
+
+	single, err := service.SimulateSeason(1, SimulationOptions{Runs: 1234, RandomSeed: 42, Workers: 1})
+	if err != nil {
+		t.Fatalf("SimulateSeason() error = %v", err)
+	}
+	parallel, err := service.SimulateSeason(1, SimulationOptions{Runs: 1234, RandomSeed: 42, Workers: 8})
+	if err != nil {
+		t.Fatalf("SimulateSeason() error = %v", err)
+	}
+	if !reflect.DeepEqual(single, parallel) {
+		t.Errorf("simulations with the same seed differ:\n%+v\n%+v", single, parallel)
+	}
+
+	for position := 0; position < 3; position++ {
+		total := 0.0
+		for _, team := range single.Teams {
+			total += team.PositionProbabilities[position]
+		}
+		if math.Abs(total-1) > 1e-9 {
+			t.Errorf("probabilities of finishing %d sum to %.4f, want 1", position+1, total)
+		}
+	}
+}
+
+// TestBatchSeeds tests that the batch seeds of a simulation are reproducible and not shared
+// with the batches of a simulation with the next seed
+func TestBatchSeeds(t *testing.T) {
+	seeds := batchSeeds(42, 8)
+	if !reflect.DeepEqual(seeds, batchSeeds(42, 8)) {
+		t.Errorf("batch seeds of the same simulation seed differ")
+	}
+
+	used := make(map[int64]bool, len(seeds))
+	for _, seed := range seeds {
+		used[seed] = true
+	}
+	for batch, seed := range batchSeeds(43, 8) {
+		if used[seed] {
+			t.Errorf("batch %d of seed 43 replays a batch of seed 42", batch)
+		}
+	}
+}
+
+// TestSimulationService_FinishedSeason tests that a season without remaining matches ends
+// in its current order, with sanctions, knockout and cancelled matches left out
+func TestSimulationService_FinishedSeason(t *testing.T) {
+	service, matchRepo, sanctionRepo := newTestSimulationService()
+	matchRepo.Create(newFinishedMatch(1, 2, 1, 2, 0))
+	sanctionRepo.Create(&entities.TeamSanction{SeasonID: 1, TeamID: 2, PointsDeducted: 4})
+	cancelled := newScheduledMatch(1, 1, 3)
+	cancelled.Status = string(entities.MatchStatusCancelled)
+	matchRepo.Create(cancelled)
+	final := newScheduledMatch(1, 1, 3)
+	final.Stage = entities.MatchStageFinal
+	matchRepo.Create(final)
+
+	simulation, err := service.SimulateSeason(1, SimulationOptions{Runs: 10, RandomSeed: 1})
+	if err != nil {
+		t.Fatalf("SimulateSeason() error = %v", err)
+	}
+	if simulation.RemainingMatches != 0 {
+		t.Errorf("got %d remaining matches, want 0", simulation.RemainingMatches)
+	}
+	for _, team := range simulation.Teams {
+		if team.PositionProbabilities[team.CurrentPosition-1] != 1 {
+			t.Errorf("team %d = %+v, want it certain to finish in its current position", team.TeamID, team)
+		}
+	}
+	if team := simulatedTeam(t, simulation, 2); team.CurrentPoints != 1 || team.CurrentPosition != 3 {
+		t.Errorf("team B = %+v, want 1 point after the deduction and third place", team)
+	}
+}
+
+// TestSimulationService_InvalidRuns tests that the number of runs is bounded
+func TestSimulationService_InvalidRuns(t *testing.T) {
+	service, _, _ := newTestSimulationService()
+
+	for _, runs := range []int{-1, MaxSimulationRuns + 1} {
+		if _, err := service.SimulateSeason(1, SimulationOptions{Runs: runs}); err == nil {
+			t.Errorf("SimulateSeason() with %d runs should fail", runs)
+		}
+	}
+}
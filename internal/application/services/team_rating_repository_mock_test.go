@@ -0,0 +1,59 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+)
+
+// MockTeamRatingRepository is an in-memory implementation of TeamRatingRepository for testing
+type MockTeamRatingRepository struct {
+	ratings  []entities.TeamRating
+	nextID   uint
+	replaced int
+}
+
+// NewMockTeamRatingRepository creates a new mock team rating repository
+func NewMockTeamRatingRepository() *MockTeamRatingRepository {
+	return &MockTeamRatingRepository{nextID: 1}
+}
+
+func (m *MockTeamRatingRepository) Create(ratings []entities.TeamRating) error {
+	for _, rating := range ratings {
+		rating.ID = m.nextID
+		m.nextID++
+		m.ratings = append(m.ratings, rating)
+	}
+	return nil
+}
+
+func (m *MockTeamRatingRepository) ReplaceAll(ratings []entities.TeamRating) error {
+	m.ratings = nil
+	m.replaced++
+	return m.Create(ratings)
+}
+
+func (m *MockTeamRatingRepository) GetByTeamID(teamID uint) ([]entities.TeamRating, error) {
+	return m.filter(func(rating *entities.TeamRating) bool { return rating.TeamID == teamID }), nil
+}
+
+func (m *MockTeamRatingRepository) GetByMatchID(matchID uint) ([]entities.TeamRating, error) {
+	return m.filter(func(rating *entities.TeamRating) bool { return rating.MatchID == matchID }), nil
+}
+
+func (m *MockTeamRatingRepository) GetLatest() ([]entities.TeamRating, error) {
+	latest := make(map[uint]entities.TeamRating)
+	for _, rating := range m.ratings {
+		latest[rating.TeamID] = rating
+	}
+	return m.filter(func(rating *entities.TeamRating) bool { return latest[rating.TeamID].ID == rating.ID }), nil
+}
+
+// filter returns the stored ratings that satisfy keep, in the order they were stored
+func (m *MockTeamRatingRepository) filter(keep func(*entities.TeamRating) bool) []entities.TeamRating {
+	ratings := make([]entities.TeamRating, 0)
+	for i := range m.ratings {
+		if keep(&m.ratings[i]) {
+			ratings = append(ratings, m.ratings[i])
+		}
+	}
+	return ratings
+}
@@ -0,0 +1,84 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"sort"
+)
+
+// MockTeamRepository is an in-memory implementation of TeamRepository for testing
+type MockTeamRepository struct {
+	teams  map[uint]*entities.Team
+	nextID uint
+}
+
+// NewMockTeamRepository creates a new mock team repository holding the given team IDs
+func NewMockTeamRepository(ids ...uint) *MockTeamRepository {
+	m := &MockTeamRepository{
+		teams:  make(map[uint]*entities.Team),
+		nextID: 1,
+	}
+	for _, id := range ids {
+		m.Create(&entities.Team{ID: id, Name: teamName(id)})
+	}
+	return m
+}
+
+func (m *MockTeamRepository) Create(team *entities.Team) error {
+	if team.ID == 0 {
+		team.ID = m.nextID
+	}
+	if team.ID >= m.nextID {
+		m.nextID = team.ID + 1
+	}
+	stored := *team
+	m.teams[team.ID] = &stored
+	return nil
+}
+
+func (m *MockTeamRepository) GetByID(id uint) (*entities.Team, error) {
+	if team, exists := m.teams[id]; exists {
+		found := *team
+		return &found, nil
+	}
+	return nil, errors.New("record not found")
+}
+
+func (m *MockTeamRepository) GetAll() ([]entities.Team, error) {
+	teams := make([]entities.Team, 0, len(m.teams))
+	for _, team := range m.teams {
+		teams = append(teams, *team)
+	}
+	sort.Slice(teams, func(i, j int) bool { return teams[i].ID < teams[j].ID })
+	return teams, nil
+}
+
+func (m *MockTeamRepository) Update(team *entities.Team) error {
+	if _, exists := m.teams[team.ID]; !exists {
+		return errors.New("record not found")
+	}
+	stored := *team
+	m.teams[team.ID] = &stored
+	return nil
+}
+
+func (m *MockTeamRepository) Delete(id uint) error {
+	delete(m.teams, id)
+	return nil
+}
+
+func (m *MockTeamRepository) GetWithPlayers(id uint) (*entities.Team, error) {
+	return m.GetByID(id)
+}
+
+func (m *MockTeamRepository) GetWithTags(id uint) (*entities.Team, error) {
+	return m.GetByID(id)
+}
+
+func (m *MockTeamRepository) GetBySeasonID(seasonID uint) ([]entities.Team, error) {
+	return nil, errors.New("not supported by the mock")
+}
+
+func (m *MockTeamRepository) GetStandings(seasonID uint) ([]entities.Team, error) {
+	return nil, errors.New("not supported by the mock")
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"sort"
+)
+
+// MockTeamSanctionRepository is an in-memory implementation of TeamSanctionRepository for testing
+type MockTeamSanctionRepository struct {
+	sanctions map[uint]*entities.TeamSanction
+	nextID    uint
+}
+
+// NewMockTeamSanctionRepository creates a new mock team sanction repository
+func NewMockTeamSanctionRepository() *MockTeamSanctionRepository {
+	return &MockTeamSanctionRepository{
+		sanctions: make(map[uint]*entities.TeamSanction),
+		nextID:    1,
+	}
+}
+
+func (m *MockTeamSanctionRepository) Create(sanction *entities.TeamSanction) error {
+	sanction.ID = m.nextID
+	stored := *sanction
+	m.sanctions[sanction.ID] = &stored
+	m.nextID++
+	return nil
+}
+
+func (m *MockTeamSanctionRepository) GetByID(id uint) (*entities.TeamSanction, error) {
+	sanction, exists := m.sanctions[id]
+	if !exists {
+		return nil, errors.New("record not found")
+	}
+	found := *sanction
+	return &found, nil
+}
+
+func (m *MockTeamSanctionRepository) GetBySeasonID(seasonID uint) ([]entities.TeamSanction, error) {
+	sanctions := make([]entities.TeamSanction, 0)
+	for _, sanction := range m.sanctions {
+		if sanction.SeasonID == seasonID {
+			sanctions = append(sanctions, *sanction)
+		}
+	}
+	sort.Slice(sanctions, func(i, j int) bool { return sanctions[i].ID < sanctions[j].ID })
+	return sanctions, nil
+}
+
+func (m *MockTeamSanctionRepository) Save(sanction *entities.TeamSanction) error {
+	if _, exists := m.sanctions[sanction.ID]; !exists {
+		return errors.New("record not found")
+	}
+	stored := *sanction
+	m.sanctions[sanction.ID] = &stored
+	return nil
+}
+
+func (m *MockTeamSanctionRepository) Delete(id uint) error {
+	delete(m.sanctions, id)
+	return nil
+}
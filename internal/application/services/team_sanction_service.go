@@ -0,0 +1,145 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TeamSanctionService handles the points deducted from teams during a season
+type TeamSanctionService struct {
+	sanctionRepo repositories.TeamSanctionRepository
+	seasonRepo   repositories.SeasonRepository
+	matchRepo    repositories.MatchRepository
+}
+
+// NewTeamSanctionService creates a new team sanction service instance
+func NewTeamSanctionService(sanctionRepo repositories.TeamSanctionRepository, seasonRepo repositories.SeasonRepository, matchRepo repositories.MatchRepository) *TeamSanctionService {
+	return &TeamSanctionService{
+		sanctionRepo: sanctionRepo,
+		seasonRepo:   seasonRepo,
+		matchRepo:    matchRepo,
+	}
+}
+
+// CreateSanction deducts points from a team of the season; without a date it is dated today
+func (s *TeamSanctionService) CreateSanction(seasonID uint, sanction *entities.TeamSanction) error {
+	sanction.ID = 0
+	sanction.SeasonID = seasonID
+	if sanction.Date.IsZero() {
+		sanction.Date = time.Now()
+	}
+
+	if err := s.validateSanction(sanction); err != nil {
+		return err
+	}
+
+	return s.sanctionRepo.Create(sanction)
+}
+
+// GetSanctions retrieves the sanctions of a season in date order
+func (s *TeamSanctionService) GetSanctions(seasonID uint) ([]entities.TeamSanction, error) {
+	if seasonID == 0 {
+		return nil, errors.New("invalid season ID")
+	}
+
+	return s.sanctionRepo.GetBySeasonID(seasonID)
+}
+
+// GetSanction retrieves a sanction and checks that it belongs to the season
+func (s *TeamSanctionService) GetSanction(seasonID, sanctionID uint) (*entities.TeamSanction, error) {
+	if seasonID == 0 {
+		return nil, errors.New("invalid season ID")
+	}
+
+	if sanctionID == 0 {
+		return nil, errors.New("invalid team sanction ID")
+	}
+
+	sanction, err := s.sanctionRepo.GetByID(sanctionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if sanction.SeasonID != seasonID {
+		return nil, errors.New("team sanction does not belong to this season")
+	}
+
+	return sanction, nil
+}
+
+// UpdateSanction replaces a sanction of the season; without a date the previous one is kept
+func (s *TeamSanctionService) UpdateSanction(seasonID uint, sanction *entities.TeamSanction) error {
+	existing, err := s.GetSanction(seasonID, sanction.ID)
+	if err != nil {
+		return err
+	}
+
+	sanction.SeasonID = seasonID
+	sanction.CreatedAt = existing.CreatedAt
+	if sanction.Date.IsZero() {
+		sanction.Date = existing.Date
+	}
+
+	if err := s.validateSanction(sanction); err != nil {
+		return err
+	}
+
+	return s.sanctionRepo.Save(sanction)
+}
+
+// DeleteSanction removes a sanction of the season, giving the points back
+func (s *TeamSanctionService) DeleteSanction(seasonID, sanctionID uint) error {
+	if _, err := s.GetSanction(seasonID, sanctionID); err != nil {
+		return err
+	}
+
+	return s.sanctionRepo.Delete(sanctionID)
+}
+
+// validateSanction checks that a sanction deducts points from a team of the season and that
+// the match it refers to, if any, was played by that team in the season
+func (s *TeamSanctionService) validateSanction(sanction *entities.TeamSanction) error {
+	if sanction.SeasonID == 0 {
+		return errors.New("invalid season ID")
+	}
+
+	if sanction.TeamID == 0 {
+		return errors.New("team ID is required")
+	}
+
+	if sanction.PointsDeducted <= 0 {
+		return errors.New("points deducted must be positive")
+	}
+
+	sanction.Reason = strings.TrimSpace(sanction.Reason)
+	if sanction.Reason == "" {
+		return errors.New("reason is required")
+	}
+
+	season, err := s.seasonRepo.GetWithTeams(sanction.SeasonID)
+	if err != nil {
+		return err
+	}
+	if !seasonHasTeam(season, sanction.TeamID) {
+		return fmt.Errorf("team %d does not play in season %d", sanction.TeamID, sanction.SeasonID)
+	}
+
+	if sanction.MatchID != nil {
+		match, err := s.matchRepo.GetByID(*sanction.MatchID)
+		if err != nil {
+			return err
+		}
+		if match.SeasonID != sanction.SeasonID {
+			return fmt.Errorf("match %d is not part of season %d", match.ID, sanction.SeasonID)
+		}
+		if match.HomeTeamID != sanction.TeamID && match.AwayTeamID != sanction.TeamID {
+			return fmt.Errorf("team %d did not play match %d", sanction.TeamID, match.ID)
+		}
+	}
+
+	return nil
+}
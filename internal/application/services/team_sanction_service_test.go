@@ -0,0 +1,88 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"testing"
+)
+
+// TestTeamSanctionService_DeductsLeaderboardPoints tests that a sanction lowers the points of
+// a team in a separate column and that deleting it gives the points back
+func TestTeamSanctionService_DeductsLeaderboardPoints(t *testing.T) {
+	matchRepo := NewMockMatchRepository()
+	ruleSetService, _, seasonRepo := newTestRuleSetService()
+	enrollTeams(seasonRepo, 1, 1, 2, 3)
+	sanctionRepo := NewMockTeamSanctionRepository()
+	service := NewTeamSanctionService(sanctionRepo, seasonRepo, matchRepo)
+	leaderboardService := NewLeaderboardService(matchRepo, NewMockMatchPlayerRepository(matchRepo), ruleSetService, sanctionRepo)
+
+	win := newFinishedMatch(1, 1, 2, 2, 0)
+	matchRepo.Create(win)
+	matchRepo.Create(newFinishedMatch(1, 2, 3, 1, 0))
+	matchRepo.Create(newFinishedMatch(1, 3, 1, 0, 0))
+
+	sanction := &entities.TeamSanction{TeamID: 1, MatchID: &win.ID, PointsDeducted: 4, Reason: "Ineligible player fielded"}
+	if err := service.CreateSanction(1, sanction); err != nil {
+		t.Fatalf("CreateSanction() error = %v", err)
+	}
+	if sanction.Date.IsZero() {
+		t.Error("sanction without a date should be dated today")
+	}
+
+	leaderboard, err := leaderboardService.GenerateLeaderboard(1)
+	if err != nil {
+		t.Fatalf("GenerateLeaderboard() error = %v", err)
+	}
+	assertOrder(t, leaderboard, 2, 3, 1)
+	if last := leaderboard[2]; last.Points != 0 || last.PointsDeducted != 4 {
+		t.Errorf("team 1 has %d points with %d deducted, want 0 with 4 deducted", last.Points, last.PointsDeducted)
+	}
+
+	if err := service.DeleteSanction(1, sanction.ID); err != nil {
+		t.Fatalf("DeleteSanction() error = %v", err)
+	}
+	leaderboard, _ = leaderboardService.GenerateLeaderboard(1)
+	if leaderboard[0].TeamID != 1 || leaderboard[0].Points != 4 || leaderboard[0].PointsDeducted != 0 {
+		t.Errorf("leader = %+v, want team 1 with 4 points", leaderboard[0])
+	}
+}
+
+// TestTeamSanctionService_Validation tests that sanctions must deduct points from a team of the
+// season, for a match that team played in the season
+func TestTeamSanctionService_Validation(t *testing.T) {
+	matchRepo := NewMockMatchRepository()
+	_, _, seasonRepo := newTestRuleSetService()
+	enrollTeams(seasonRepo, 1, 1, 2, 3)
+	service := NewTeamSanctionService(NewMockTeamSanctionRepository(), seasonRepo, matchRepo)
+
+	match := newFinishedMatch(1, 2, 3, 1, 0)
+	matchRepo.Create(match)
+	otherSeason := newFinishedMatch(2, 1, 2, 1, 0)
+	matchRepo.Create(otherSeason)
+
+	tests := []struct {
+		name     string
+		sanction entities.TeamSanction
+	}{
+		{name: "no points", sanction: entities.TeamSanction{TeamID: 1, Reason: "Unpaid fees"}},
+		{name: "negative points", sanction: entities.TeamSanction{TeamID: 1, PointsDeducted: -2, Reason: "Unpaid fees"}},
+		{name: "missing reason", sanction: entities.TeamSanction{TeamID: 1, PointsDeducted: 2}},
+		{name: "team not enrolled", sanction: entities.TeamSanction{TeamID: 7, PointsDeducted: 2, Reason: "Unpaid fees"}},
+		{name: "match of another team", sanction: entities.TeamSanction{TeamID: 1, MatchID: &match.ID, PointsDeducted: 2, Reason: "Ineligible player"}},
+		{name: "match of another season", sanction: entities.TeamSanction{TeamID: 1, MatchID: &otherSeason.ID, PointsDeducted: 2, Reason: "Ineligible player"}},
+	}
+
+	for _, tt := range tests {
+		sanction := tt.sanction
+		if err := service.CreateSanction(1, &sanction); err == nil {
+			t.Errorf("CreateSanction() with %s should fail", tt.name)
+		}
+	}
+
+	sanction := &entities.TeamSanction{TeamID: 2, PointsDeducted: 1, Reason: "Unpaid fees"}
+	if err := service.CreateSanction(1, sanction); err != nil {
+		t.Fatalf("CreateSanction() error = %v", err)
+	}
+	if _, err := service.GetSanction(2, sanction.ID); err == nil {
+		t.Error("GetSanction() from another season should fail")
+	}
+}
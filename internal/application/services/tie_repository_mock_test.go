@@ -0,0 +1,84 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"sort"
+)
+
+// MockTieRepository is an in-memory implementation of TieRepository for testing.
+// Legs are loaded from the linked match repository.
+type MockTieRepository struct {
+	matchRepo *MockMatchRepository
+	ties      map[uint]*entities.Tie
+	nextID    uint
+}
+
+// NewMockTieRepository creates a new mock tie repository reading legs from matchRepo
+func NewMockTieRepository(matchRepo *MockMatchRepository) *MockTieRepository {
+	return &MockTieRepository{
+		matchRepo: matchRepo,
+		ties:      make(map[uint]*entities.Tie),
+		nextID:    1,
+	}
+}
+
+func (m *MockTieRepository) Create(tie *entities.Tie) error {
+	tie.ID = m.nextID
+	stored := *tie
+	m.ties[tie.ID] = &stored
+	m.nextID++
+	return nil
+}
+
+func (m *MockTieRepository) GetByID(id uint) (*entities.Tie, error) {
+	tie, exists := m.ties[id]
+	if !exists {
+		return nil, errors.New("record not found")
+	}
+	return m.withLegs(tie)
+}
+
+func (m *MockTieRepository) GetBySeasonID(seasonID uint) ([]entities.Tie, error) {
+	ties := make([]entities.Tie, 0)
+	for _, tie := range m.ties {
+		if tie.SeasonID == seasonID {
+			found, err := m.withLegs(tie)
+			if err != nil {
+				return nil, err
+			}
+			ties = append(ties, *found)
+		}
+	}
+	sort.Slice(ties, func(i, j int) bool { return ties[i].ID < ties[j].ID })
+	return ties, nil
+}
+
+func (m *MockTieRepository) FindByMatchID(matchID uint) (*entities.Tie, error) {
+	for _, tie := range m.ties {
+		if tie.FirstLegID == matchID || tie.SecondLegID == matchID {
+			return m.withLegs(tie)
+		}
+	}
+	return nil, nil
+}
+
+func (m *MockTieRepository) Delete(id uint) error {
+	delete(m.ties, id)
+	return nil
+}
+
+// withLegs returns a copy of the tie with both legs loaded
+func (m *MockTieRepository) withLegs(tie *entities.Tie) (*entities.Tie, error) {
+	found := *tie
+	first, err := m.matchRepo.GetByID(tie.FirstLegID)
+	if err != nil {
+		return nil, err
+	}
+	second, err := m.matchRepo.GetByID(tie.SecondLegID)
+	if err != nil {
+		return nil, err
+	}
+	found.FirstLeg, found.SecondLeg = *first, *second
+	return &found, nil
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"errors"
+)
+
+// TieService handles knockout pairings played over two legs
+type TieService struct {
+	tieRepo   repositories.TieRepository
+	matchRepo repositories.MatchRepository
+}
+
+// NewTieService creates a new tie service instance
+func NewTieService(tieRepo repositories.TieRepository, matchRepo repositories.MatchRepository) *TieService {
+	return &TieService{
+		tieRepo:   tieRepo,
+		matchRepo: matchRepo,
+	}
+}
+
+// CreateTie links two knockout matches of the same bracket slot as the legs of a tie. The
+// second leg must be played after the first one with home and away swapped.
+func (s *TieService) CreateTie(tie *entities.Tie) (*entities.TieResult, error) {
+	if tie.FirstLegID == 0 || tie.SecondLegID == 0 || tie.FirstLegID == tie.SecondLegID {
+		return nil, errors.New("two different legs are required")
+	}
+
+	first, err := s.matchRepo.GetByID(tie.FirstLegID)
+	if err != nil {
+		return nil, err
+	}
+	second, err := s.matchRepo.GetByID(tie.SecondLegID)
+	if err != nil {
+		return nil, err
+	}
+
+	if first.SeasonID != second.SeasonID || first.Stage != second.Stage || first.BracketSlot != second.BracketSlot {
+		return nil, errors.New("legs must belong to the same season, stage and bracket slot")
+	}
+
+	if first.Stage == "" || first.Stage == entities.MatchStageRegular {
+		return nil, errors.New("only knockout matches can be played over two legs")
+	}
+
+	if first.HomeTeamID != second.AwayTeamID || first.AwayTeamID != second.HomeTeamID {
+		return nil, errors.New("second leg must swap the home and away teams of the first leg")
+	}
+
+	if !second.Date.After(first.Date) {
+		return nil, errors.New("second leg must be played after the first leg")
+	}
+
+	for _, leg := range []uint{first.ID, second.ID} {
+		existing, err := s.tieRepo.FindByMatchID(leg)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return nil, errors.New("match is already a leg of another tie")
+		}
+	}
+
+	tie.ID = 0
+	tie.SeasonID = first.SeasonID
+	tie.Stage = first.Stage
+	tie.BracketSlot = first.BracketSlot
+	if err := s.tieRepo.Create(tie); err != nil {
+		return nil, err
+	}
+
+	return s.GetTie(tie.ID)
+}
+
+// GetTie retrieves a tie with its aggregate score
+func (s *TieService) GetTie(id uint) (*entities.TieResult, error) {
+	if id == 0 {
+		return nil, errors.New("invalid tie ID")
+	}
+
+	tie, err := s.tieRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := tie.Result()
+	return &result, nil
+}
+
+// GetSeasonTies retrieves the ties of a season with their aggregate scores
+func (s *TieService) GetSeasonTies(seasonID uint) ([]entities.TieResult, error) {
+	if seasonID == 0 {
+		return nil, errors.New("invalid season ID")
+	}
+
+	ties, err := s.tieRepo.GetBySeasonID(seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]entities.TieResult, 0, len(ties))
+	for i := range ties {
+		results = append(results, ties[i].Result())
+	}
+	return results, nil
+}
+
+// DeleteTie unlinks the legs of a tie, leaving both matches in place
+func (s *TieService) DeleteTie(id uint) error {
+	if id == 0 {
+		return errors.New("invalid tie ID")
+	}
+
+	return s.tieRepo.Delete(id)
+}
+
+// FindByMatch returns the result of the tie a match is a leg of, or nil for a single match
+func (s *TieService) FindByMatch(matchID uint) (*entities.TieResult, error) {
+	tie, err := s.tieRepo.FindByMatchID(matchID)
+	if err != nil || tie == nil {
+		return nil, err
+	}
+
+	result := tie.Result()
+	return &result, nil
+}
@@ -0,0 +1,185 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"testing"
+	"time"
+)
+
+// newTestTie stores two finished legs between teams 1 and 2 and links them in a tie
+func newTestTie(t *testing.T, awayGoalsRule bool, first, second [2]int, extraTime, penalties *[2]int) *entities.TieResult {
+	t.Helper()
+	matchRepo := NewMockMatchRepository()
+	service := NewTieService(NewMockTieRepository(matchRepo), matchRepo)
+
+	firstLeg := newFinishedMatch(1, 1, 2, first[0], first[1])
+	firstLeg.Stage = entities.MatchStageSemis
+	matchRepo.Create(firstLeg)
+
+	secondLeg := newFinishedMatch(1, 2, 1, second[0], second[1])
+	secondLeg.Stage = entities.MatchStageSemis
+	secondLeg.Date = firstLeg.Date.Add(7 * 24 * time.Hour)
+	if extraTime != nil {
+		secondLeg.HomeExtraTimeScore, secondLeg.AwayExtraTimeScore = &extraTime[0], &extraTime[1]
+	}
+	if penalties != nil {
+		secondLeg.HomePenaltyScore, secondLeg.AwayPenaltyScore = &penalties[0], &penalties[1]
+	}
+	matchRepo.Create(secondLeg)
+
+	result, err := service.CreateTie(&entities.Tie{FirstLegID: firstLeg.ID, SecondLegID: secondLeg.ID, AwayGoalsRule: awayGoalsRule})
+	if err != nil {
+		t.Fatalf("CreateTie() error = %v", err)
+	}
+	return result
+}
+
+// TestTie_Result tests the aggregate score and every way a tie can be decided
+func TestTie_Result(t *testing.T) {
+	tests := []struct {
+		name          string
+		awayGoalsRule bool
+		first, second [2]int
+		extraTime     *[2]int
+		penalties     *[2]int
+		wantAggregate [2]int
+		wantWinner    uint
+		wantDecision  entities.TieDecision
+	}{
+		{name: "aggregate", first: [2]int{2, 0}, second: [2]int{1, 0}, wantAggregate: [2]int{2, 1}, wantWinner: 1, wantDecision: entities.TieDecidedOnAggregate},
+		{name: "away goals", awayGoalsRule: true, first: [2]int{1, 2}, second: [2]int{0, 1}, wantAggregate: [2]int{2, 2}, wantWinner: 2, wantDecision: entities.TieDecidedOnAwayGoals},
+		{name: "away goals ignored without the rule", first: [2]int{1, 2}, second: [2]int{0, 1}, wantAggregate: [2]int{2, 2}},
+		{name: "extra time", first: [2]int{1, 0}, second: [2]int{1, 0}, extraTime: &[2]int{0, 1}, wantAggregate: [2]int{2, 1}, wantWinner: 1, wantDecision: entities.TieDecidedInExtraTime},
+		{name: "away goal in extra time", awayGoalsRule: true, first: [2]int{1, 0}, second: [2]int{1, 0}, extraTime: &[2]int{1, 1}, wantAggregate: [2]int{2, 2}, wantWinner: 1, wantDecision: entities.TieDecidedOnAwayGoals},
+		{name: "penalties", awayGoalsRule: true, first: [2]int{1, 1}, second: [2]int{1, 1}, extraTime: &[2]int{0, 0}, penalties: &[2]int{4, 3}, wantAggregate: [2]int{2, 2}, wantWinner: 2, wantDecision: entities.TieDecidedOnPenalties},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := newTestTie(t, tt.awayGoalsRule, tt.first, tt.second, tt.extraTime, tt.penalties)
+			if got := [2]int{result.HomeAggregate, result.AwayAggregate}; got != tt.wantAggregate {
+				t.Errorf("aggregate = %v, want %v", got, tt.wantAggregate)
+			}
+			if result.Decided != (tt.wantWinner != 0) || result.WinnerID != tt.wantWinner || result.DecidedBy != tt.wantDecision {
+				t.Errorf("winner = %d decided by %q, want %d decided by %q", result.WinnerID, result.DecidedBy, tt.wantWinner, tt.wantDecision)
+			}
+		})
+	}
+}
+
+// TestTieService_CreateTieValidation tests that only swapped knockout legs can form a tie
+func TestTieService_CreateTieValidation(t *testing.T) {
+	matchRepo := NewMockMatchRepository()
+	service := NewTieService(NewMockTieRepository(matchRepo), matchRepo)
+
+	leg := func(home, away uint, stage entities.MatchStage, day int) uint {
+		match := newFinishedMatch(1, home, away, 0, 0)
+		match.Stage = stage
+		match.Date = time.Date(2025, 5, day, 20, 0, 0, 0, time.UTC)
+		matchRepo.Create(match)
+		return match.ID
+	}
+	first := leg(1, 2, entities.MatchStageSemis, 1)
+	second := leg(2, 1, entities.MatchStageSemis, 8)
+
+	tests := []struct {
+		name          string
+		first, second uint
+	}{
+		{name: "same match", first: first, second: first},
+		{name: "teams not swapped", first: first, second: leg(1, 2, entities.MatchStageSemis, 8)},
+		{name: "different stage", first: first, second: leg(2, 1, entities.MatchStageFinal, 8)},
+		{name: "league matches", first: leg(3, 4, entities.MatchStageRegular, 1), second: leg(4, 3, entities.MatchStageRegular, 8)},
+		{name: "second leg first", first: second, second: first},
+	}
+	for _, tt := range tests {
+		if _, err := service.CreateTie(&entities.Tie{FirstLegID: tt.first, SecondLegID: tt.second}); err == nil {
+			t.Errorf("CreateTie() with %s should fail", tt.name)
+		}
+	}
+
+	if _, err := service.CreateTie(&entities.Tie{FirstLegID: first, SecondLegID: second}); err != nil {
+		t.Fatalf("CreateTie() error = %v", err)
+	}
+	if _, err := service.CreateTie(&entities.Tie{FirstLegID: first, SecondLegID: second}); err == nil {
+		t.Error("CreateTie() should reject legs that already form a tie")
+	}
+}
+
+// TestPlayoffService_TwoLeggedSemis tests that the final is only created once both ties are
+// decided and that it is contested by the tie winners
+func TestPlayoffService_TwoLeggedSemis(t *testing.T) {
+	repo := NewMockMatchRepository()
+	ruleSetService, _, _ := newTestRuleSetService()
+	matchService := NewMatchService(repo, ruleSetService)
+	leaderboardService := NewLeaderboardService(repo, NewMockMatchPlayerRepository(repo), ruleSetService, NewMockTeamSanctionRepository())
+	groupService := NewGroupService(NewMockGroupRepository(), NewMockSeasonRepository(), repo, leaderboardService)
+	tieService := NewTieService(NewMockTieRepository(repo), repo)
+	playoffService := NewPlayoffService(repo, leaderboardService, groupService, tieService)
+	matchService.OnMatchFinished(playoffService.AdvanceBracket)
+
+	for home := uint(1); home <= 4; home++ {
+		for away := home + 1; away <= 4; away++ {
+			repo.Create(newFinishedMatch(1, home, away, 1, 0))
+		}
+	}
+
+	semis, err := playoffService.CreatePlayoffs(1, PlayoffOptions{Teams: 4, StadiumID: 1, Date: time.Date(2025, 6, 1, 15, 0, 0, 0, time.UTC)})
+	if err != nil {
+		t.Fatalf("CreatePlayoffs() error = %v", err)
+	}
+
+	secondLegs := make([]entities.Match, 0, len(semis))
+	for _, first := range semis {
+		second := first
+		second.HomeTeamID, second.AwayTeamID = first.AwayTeamID, first.HomeTeamID
+		second.Date = first.Date.Add(7 * 24 * time.Hour)
+		repo.Create(&second)
+		if _, err := tieService.CreateTie(&entities.Tie{FirstLegID: first.ID, SecondLegID: second.ID, AwayGoalsRule: true}); err != nil {
+			t.Fatalf("CreateTie() error = %v", err)
+		}
+		secondLegs = append(secondLegs, second)
+	}
+
+	// Seed 4 wins the first tie on away goals, seed 2 needs penalties against seed 3
+	finishMatch(t, repo, matchService, semis[0], 2, 2)
+	finishMatch(t, repo, matchService, semis[1], 1, 0)
+	finishMatch(t, repo, matchService, secondLegs[0], 1, 1)
+	if finals, _ := repo.GetByStage(1, entities.MatchStageFinal); len(finals) != 0 {
+		t.Fatal("final created before the second tie was decided")
+	}
+
+	noGoals := 0
+	secondLegs[1].Status = string(entities.MatchStatusFinished)
+	repo.Update(&secondLegs[1])
+	if err := matchService.UpdateMatchResult(secondLegs[1].ID, MatchResult{
+		HomeScore:          1,
+		AwayScore:          0,
+		HomeExtraTimeScore: &noGoals,
+		AwayExtraTimeScore: &noGoals,
+		PenaltyKicks:       shootout(3, 2, []bool{true, true, false, true, true, true}),
+	}); err != nil {
+		t.Fatalf("UpdateMatchResult() error = %v", err)
+	}
+
+	finals, _ := repo.GetByStage(1, entities.MatchStageFinal)
+	if len(finals) != 1 || finals[0].HomeTeamID != 4 || finals[0].AwayTeamID != 2 {
+		t.Fatalf("finals = %+v, want 4 v 2", finals)
+	}
+	if want := secondLegs[1].Date.Add(playoffRoundInterval); !finals[0].Date.Equal(want) {
+		t.Errorf("final date = %v, want a week after the last second leg (%v)", finals[0].Date, want)
+	}
+	third, _ := repo.GetByStage(1, entities.MatchStageThird)
+	if len(third) != 1 || third[0].HomeTeamID != 1 || third[0].AwayTeamID != 3 {
+		t.Errorf("third place = %+v, want 1 v 3", third)
+	}
+
+	bracket, err := playoffService.GetBracket(1)
+	if err != nil {
+		t.Fatalf("GetBracket() error = %v", err)
+	}
+	feeder := bracket.Final.Feeders[0]
+	if feeder.Tie == nil || feeder.Tie.WinnerID != 4 || feeder.Match == nil || feeder.Match.ID != semis[0].ID {
+		t.Errorf("first semi node = %+v, want the first leg with the tie won by 4", feeder)
+	}
+}
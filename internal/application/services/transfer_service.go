@@ -0,0 +1,291 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrTransferWindowClosed is returned when a transfer is requested outside the windows of a season
+var ErrTransferWindowClosed = errors.New("transfer window is closed")
+
+// TransferService handles player registrations, transfers between teams and transfer windows
+type TransferService struct {
+	registrationRepo repositories.PlayerRegistrationRepository
+	windowRepo       repositories.TransferWindowRepository
+	playerRepo       repositories.PlayerRepository
+	seasonRepo       repositories.SeasonRepository
+}
+
+// NewTransferService creates a new transfer service instance
+func NewTransferService(registrationRepo repositories.PlayerRegistrationRepository, windowRepo repositories.TransferWindowRepository, playerRepo repositories.PlayerRepository, seasonRepo repositories.SeasonRepository) *TransferService {
+	return &TransferService{
+		registrationRepo: registrationRepo,
+		windowRepo:       windowRepo,
+		playerRepo:       playerRepo,
+		seasonRepo:       seasonRepo,
+	}
+}
+
+// CreateTransferWindow opens a transfer window for a season
+func (s *TransferService) CreateTransferWindow(seasonID uint, window *entities.TransferWindow) error {
+	if seasonID == 0 {
+		return errors.New("invalid season ID")
+	}
+
+	if _, err := s.seasonRepo.GetByID(seasonID); err != nil {
+		return err
+	}
+
+	if window.OpensAt.IsZero() || window.ClosesAt.IsZero() {
+		return errors.New("opening and closing dates are required")
+	}
+
+	if !window.ClosesAt.After(window.OpensAt) {
+		return errors.New("transfer window must close after it opens")
+	}
+
+	window.ID = 0
+	window.SeasonID = seasonID
+	return s.windowRepo.Create(window)
+}
+
+// GetTransferWindows retrieves the transfer windows of a season
+func (s *TransferService) GetTransferWindows(seasonID uint) ([]entities.TransferWindow, error) {
+	if seasonID == 0 {
+		return nil, errors.New("invalid season ID")
+	}
+
+	return s.windowRepo.GetBySeasonID(seasonID)
+}
+
+// DeleteTransferWindow deletes a transfer window of a season
+func (s *TransferService) DeleteTransferWindow(seasonID, windowID uint) error {
+	window, err := s.windowRepo.GetByID(windowID)
+	if err != nil {
+		return err
+	}
+
+	if window.SeasonID != seasonID {
+		return errors.New("transfer window does not belong to this season")
+	}
+
+	return s.windowRepo.Delete(windowID)
+}
+
+// Transfer registers a player with a team for a season from the given moment, closing their
+// current registration. The moment must fall within a transfer window of the season; a zero
+// moment means now and a zero shirt number keeps the player's current number.
+func (s *TransferService) Transfer(playerID, teamID, seasonID uint, at time.Time, shirtNumber int) (*entities.PlayerRegistration, error) {
+	if playerID == 0 {
+		return nil, errors.New("invalid player ID")
+	}
+
+	if teamID == 0 {
+		return nil, errors.New("team ID is required")
+	}
+
+	if seasonID == 0 {
+		return nil, errors.New("season ID is required")
+	}
+
+	player, err := s.playerRepo.GetByID(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.seasonRepo.GetByID(seasonID); err != nil {
+		return nil, err
+	}
+
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	if err := s.checkWindow(seasonID, at); err != nil {
+		return nil, err
+	}
+
+	registrations, err := s.registrationRepo.GetByPlayerID(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	current := currentRegistration(registrations)
+	if current != nil {
+		if current.TeamID == teamID && current.SeasonID == seasonID {
+			return nil, errors.New("player is already registered with this team for this season")
+		}
+		if !at.After(current.From) {
+			return nil, errors.New("transfer date must be after the start of the current registration")
+		}
+	}
+
+	if shirtNumber == 0 {
+		shirtNumber = player.Number
+	}
+	if shirtNumber <= 0 {
+		return nil, errors.New("shirt number must be greater than 0")
+	}
+
+	if err := s.checkShirtNumber(playerID, teamID, seasonID, at, shirtNumber); err != nil {
+		return nil, err
+	}
+
+	// The spell the player leaves ends with the transfer. Players who were never registered
+	// get their spell at their current team recorded, so that it still counts for earlier matches.
+	previous := current
+	if previous == nil && len(registrations) == 0 && player.TeamID != 0 && player.TeamID != teamID {
+		from := player.CreatedAt
+		if from.IsZero() || from.After(at) {
+			from = at
+		}
+		previous = &entities.PlayerRegistration{
+			PlayerID:    playerID,
+			TeamID:      player.TeamID,
+			SeasonID:    seasonID,
+			From:        from,
+			ShirtNumber: player.Number,
+		}
+	}
+	if previous != nil {
+		previous.To = &at
+	}
+
+	registration := &entities.PlayerRegistration{
+		PlayerID:    playerID,
+		TeamID:      teamID,
+		SeasonID:    seasonID,
+		From:        at,
+		ShirtNumber: shirtNumber,
+	}
+	player.TeamID = teamID
+	player.Number = shirtNumber
+	if err := s.registrationRepo.Transfer(player, previous, registration); err != nil {
+		return nil, err
+	}
+
+	return registration, nil
+}
+
+// Register creates a player together with their first registration, at their team with their
+// shirt number, for a season from the given moment. The moment must fall within a transfer
+// window of the season; a zero moment means now.
+func (s *TransferService) Register(player *entities.Player, seasonID uint, at time.Time) (*entities.PlayerRegistration, error) {
+	if seasonID == 0 {
+		return nil, errors.New("season ID is required")
+	}
+
+	if _, err := s.seasonRepo.GetByID(seasonID); err != nil {
+		return nil, err
+	}
+
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	if err := s.checkWindow(seasonID, at); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkShirtNumber(0, player.TeamID, seasonID, at, player.Number); err != nil {
+		return nil, err
+	}
+
+	registration := &entities.PlayerRegistration{
+		TeamID:      player.TeamID,
+		SeasonID:    seasonID,
+		From:        at,
+		ShirtNumber: player.Number,
+	}
+	if err := s.registrationRepo.Register(player, registration); err != nil {
+		return nil, err
+	}
+
+	return registration, nil
+}
+
+// GetCareer retrieves the registrations of a player, oldest first
+func (s *TransferService) GetCareer(playerID uint) ([]entities.PlayerRegistration, error) {
+	if playerID == 0 {
+		return nil, errors.New("invalid player ID")
+	}
+
+	if _, err := s.playerRepo.GetByID(playerID); err != nil {
+		return nil, err
+	}
+
+	return s.registrationRepo.GetByPlayerID(playerID)
+}
+
+// IsRegistered reports whether a player is registered with a team at the given moment.
+// Players without any registration are judged by their current team, and moments before
+// the first registration of a player by the team of that registration.
+func (s *TransferService) IsRegistered(playerID, teamID uint, at time.Time) (bool, error) {
+	registrations, err := s.registrationRepo.GetByPlayerID(playerID)
+	if err != nil {
+		return false, err
+	}
+
+	if len(registrations) == 0 {
+		player, err := s.playerRepo.GetByID(playerID)
+		if err != nil {
+			return false, err
+		}
+		return player.TeamID == teamID, nil
+	}
+
+	if at.Before(registrations[0].From) {
+		return registrations[0].TeamID == teamID, nil
+	}
+
+	for i := range registrations {
+		if registrations[i].TeamID == teamID && registrations[i].ActiveOn(at) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkWindow returns ErrTransferWindowClosed unless a window of the season is open at the given moment
+func (s *TransferService) checkWindow(seasonID uint, at time.Time) error {
+	windows, err := s.windowRepo.GetBySeasonID(seasonID)
+	if err != nil {
+		return err
+	}
+
+	for i := range windows {
+		if windows[i].Contains(at) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w on %s", ErrTransferWindowClosed, at.Format("2006-01-02"))
+}
+
+// checkShirtNumber rejects a shirt number already worn by another player of the team at the given moment
+func (s *TransferService) checkShirtNumber(playerID, teamID, seasonID uint, at time.Time, shirtNumber int) error {
+	registrations, err := s.registrationRepo.GetBySeasonAndTeam(seasonID, teamID)
+	if err != nil {
+		return err
+	}
+
+	for i := range registrations {
+		registration := &registrations[i]
+		if registration.PlayerID != playerID && registration.ShirtNumber == shirtNumber && registration.ActiveOn(at) {
+			return fmt.Errorf("shirt number %d is already taken by player %d", shirtNumber, registration.PlayerID)
+		}
+	}
+	return nil
+}
+
+// currentRegistration returns the open-ended registration of a player, if any
+func currentRegistration(registrations []entities.PlayerRegistration) *entities.PlayerRegistration {
+	for i := len(registrations) - 1; i >= 0; i-- {
+		if registrations[i].To == nil {
+			return &registrations[i]
+		}
+	}
+	return nil
+}
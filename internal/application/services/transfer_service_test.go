@@ -0,0 +1,109 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"testing"
+	"time"
+)
+
+// newTestTransferService creates a transfer service for season 1 with a January window,
+// and player 1 wearing number 9 at team 1
+func newTestTransferService(t *testing.T) (*TransferService, *MockPlayerRepository) {
+	t.Helper()
+
+	playerRepo := NewMockPlayerRepository()
+	playerRepo.Create(&entities.Player{ID: 1, Name: "Ada", LastName: "Striker", TeamID: 1, Number: 9})
+	_, _, seasonRepo := newTestRuleSetService()
+
+	service := NewTransferService(NewMockPlayerRegistrationRepository(playerRepo), NewMockTransferWindowRepository(), playerRepo, seasonRepo)
+	if err := service.CreateTransferWindow(1, &entities.TransferWindow{
+		Name:     "January",
+		OpensAt:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		ClosesAt: time.Date(2025, 1, 31, 23, 59, 59, 0, time.UTC),
+	}); err != nil {
+		t.Fatalf("CreateTransferWindow() error = %v", err)
+	}
+	return service, playerRepo
+}
+
+// TestTransferService_Career tests that transfers close the previous registration and build a career
+func TestTransferService_Career(t *testing.T) {
+	service, playerRepo := newTestTransferService(t)
+
+	signed := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	if _, err := service.Transfer(1, 1, 1, signed, 0); err != nil {
+		t.Fatalf("Transfer() to the first team error = %v", err)
+	}
+	moved := time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC)
+	if _, err := service.Transfer(1, 2, 1, moved, 10); err != nil {
+		t.Fatalf("Transfer() to the second team error = %v", err)
+	}
+
+	career, err := service.GetCareer(1)
+	if err != nil {
+		t.Fatalf("GetCareer() error = %v", err)
+	}
+	if len(career) != 2 {
+		t.Fatalf("career has %d registrations, want 2", len(career))
+	}
+	if career[0].TeamID != 1 || career[0].ShirtNumber != 9 || career[0].To == nil || !career[0].To.Equal(moved) {
+		t.Errorf("first registration = %+v, want team 1 with number 9 until the transfer", career[0])
+	}
+	if career[1].TeamID != 2 || career[1].ShirtNumber != 10 || career[1].To != nil {
+		t.Errorf("second registration = %+v, want an open registration at team 2 with number 10", career[1])
+	}
+
+	player, _ := playerRepo.GetByID(1)
+	if player.TeamID != 2 || player.Number != 10 {
+		t.Errorf("player is at team %d with number %d, want team 2 with number 10", player.TeamID, player.Number)
+	}
+}
+
+// TestTransferService_Validation tests the transfer window, shirt number and duplicate checks
+func TestTransferService_Validation(t *testing.T) {
+	service, playerRepo := newTestTransferService(t)
+	playerRepo.Create(&entities.Player{ID: 2, Name: "Grace", LastName: "Keeper", TeamID: 3, Number: 1})
+
+	january := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	if _, err := service.Transfer(1, 2, 1, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), 0); !errors.Is(err, ErrTransferWindowClosed) {
+		t.Errorf("Transfer() outside the window error = %v, want ErrTransferWindowClosed", err)
+	}
+	if _, err := service.Transfer(1, 2, 1, january, 0); err != nil {
+		t.Fatalf("Transfer() error = %v", err)
+	}
+	if _, err := service.Transfer(1, 2, 1, january.AddDate(0, 0, 1), 0); err == nil {
+		t.Errorf("Transfer() to the current team should fail")
+	}
+	if _, err := service.Transfer(2, 2, 1, january.AddDate(0, 0, 1), 9); err == nil {
+		t.Errorf("Transfer() with a shirt number already taken should fail")
+	}
+	if _, err := service.Transfer(2, 2, 1, january.AddDate(0, 0, 1), 0); err != nil {
+		t.Errorf("Transfer() with a free shirt number error = %v", err)
+	}
+}
+
+// TestTransferService_FirstTransfer tests that the first transfer of a player who was never
+// registered records the spell at their current team until the transfer
+func TestTransferService_FirstTransfer(t *testing.T) {
+	service, _ := newTestTransferService(t)
+
+	moved := time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC)
+	if _, err := service.Transfer(1, 2, 1, moved, 0); err != nil {
+		t.Fatalf("Transfer() error = %v", err)
+	}
+
+	career, err := service.GetCareer(1)
+	if err != nil {
+		t.Fatalf("GetCareer() error = %v", err)
+	}
+	if len(career) != 2 {
+		t.Fatalf("career has %d registrations, want 2", len(career))
+	}
+	if career[0].TeamID != 1 || career[0].ShirtNumber != 9 || career[0].To == nil || !career[0].To.Equal(moved) {
+		t.Errorf("first registration = %+v, want team 1 with number 9 until the transfer", career[0])
+	}
+	if career[1].TeamID != 2 || career[1].To != nil {
+		t.Errorf("second registration = %+v, want an open registration at team 2", career[1])
+	}
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"sort"
+	"time"
+)
+
+// MockWebhookSubscriptionRepository is an in-memory implementation of WebhookSubscriptionRepository for testing
+type MockWebhookSubscriptionRepository struct {
+	subscriptions map[uint]*entities.WebhookSubscription
+	nextID        uint
+}
+
+// NewMockWebhookSubscriptionRepository creates a new mock webhook subscription repository
+func NewMockWebhookSubscriptionRepository() *MockWebhookSubscriptionRepository {
+	return &MockWebhookSubscriptionRepository{
+		subscriptions: make(map[uint]*entities.WebhookSubscription),
+		nextID:        1,
+	}
+}
+
+func (m *MockWebhookSubscriptionRepository) Create(subscription *entities.WebhookSubscription) error {
+	subscription.ID = m.nextID
+	stored := *subscription
+	m.subscriptions[subscription.ID] = &stored
+	m.nextID++
+	return nil
+}
+
+func (m *MockWebhookSubscriptionRepository) GetByID(id uint) (*entities.WebhookSubscription, error) {
+	if subscription, exists := m.subscriptions[id]; exists {
+		found := *subscription
+		return &found, nil
+	}
+	return nil, errors.New("record not found")
+}
+
+func (m *MockWebhookSubscriptionRepository) GetAll() ([]entities.WebhookSubscription, error) {
+	return m.filter(func(*entities.WebhookSubscription) bool { return true }), nil
+}
+
+func (m *MockWebhookSubscriptionRepository) GetActive() ([]entities.WebhookSubscription, error) {
+	return m.filter(func(subscription *entities.WebhookSubscription) bool { return !subscription.Disabled }), nil
+}
+
+func (m *MockWebhookSubscriptionRepository) Save(subscription *entities.WebhookSubscription) error {
+	stored := *subscription
+	m.subscriptions[subscription.ID] = &stored
+	return nil
+}
+
+func (m *MockWebhookSubscriptionRepository) Delete(id uint) error {
+	delete(m.subscriptions, id)
+	return nil
+}
+
+func (m *MockWebhookSubscriptionRepository) filter(keep func(*entities.WebhookSubscription) bool) []entities.WebhookSubscription {
+	subscriptions := make([]entities.WebhookSubscription, 0)
+	for _, subscription := range m.subscriptions {
+		if keep(subscription) {
+			subscriptions = append(subscriptions, *subscription)
+		}
+	}
+	sort.Slice(subscriptions, func(i, j int) bool { return subscriptions[i].ID < subscriptions[j].ID })
+	return subscriptions
+}
+
+// MockWebhookDeliveryRepository is an in-memory implementation of WebhookDeliveryRepository for testing
+type MockWebhookDeliveryRepository struct {
+	deliveries map[uint]*entities.WebhookDelivery
+	nextID     uint
+}
+
+// NewMockWebhookDeliveryRepository creates a new mock webhook delivery repository
+func NewMockWebhookDeliveryRepository() *MockWebhookDeliveryRepository {
+	return &MockWebhookDeliveryRepository{
+		deliveries: make(map[uint]*entities.WebhookDelivery),
+		nextID:     1,
+	}
+}
+
+func (m *MockWebhookDeliveryRepository) Create(delivery *entities.WebhookDelivery) error {
+	delivery.ID = m.nextID
+	stored := *delivery
+	m.deliveries[delivery.ID] = &stored
+	m.nextID++
+	return nil
+}
+
+func (m *MockWebhookDeliveryRepository) Save(delivery *entities.WebhookDelivery) error {
+	stored := *delivery
+	m.deliveries[delivery.ID] = &stored
+	return nil
+}
+
+func (m *MockWebhookDeliveryRepository) GetDue(now time.Time, limit int) ([]entities.WebhookDelivery, error) {
+	deliveries := m.filter(func(delivery *entities.WebhookDelivery) bool {
+		return delivery.Status == entities.WebhookDeliveryPending && !delivery.NextAttemptAt.After(now)
+	})
+	if len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+	}
+	return deliveries, nil
+}
+
+func (m *MockWebhookDeliveryRepository) GetBySubscriptionID(subscriptionID uint) ([]entities.WebhookDelivery, error) {
+	return m.filter(func(delivery *entities.WebhookDelivery) bool { return delivery.SubscriptionID == subscriptionID }), nil
+}
+
+func (m *MockWebhookDeliveryRepository) GetByEvent(eventType entities.WebhookEventType, eventKey string) ([]entities.WebhookDelivery, error) {
+	return m.filter(func(delivery *entities.WebhookDelivery) bool {
+		return delivery.EventType == eventType && delivery.EventKey == eventKey
+	}), nil
+}
+
+func (m *MockWebhookDeliveryRepository) filter(keep func(*entities.WebhookDelivery) bool) []entities.WebhookDelivery {
+	deliveries := make([]entities.WebhookDelivery, 0)
+	for _, delivery := range m.deliveries {
+		if keep(delivery) {
+			deliveries = append(deliveries, *delivery)
+		}
+	}
+	sort.Slice(deliveries, func(i, j int) bool { return deliveries[i].ID < deliveries[j].ID })
+	return deliveries
+}
@@ -0,0 +1,387 @@
+package services
+
+import (
+	"bytes"
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"catalyst-players/internal/infrastructure/logger"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Headers sent with every webhook delivery
+const (
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookDeliveryHeader  = "X-Webhook-Delivery"
+	WebhookSignatureHeader = "X-Webhook-Signature"
+)
+
+// webhookBatchSize is the number of due deliveries attempted per pass over the queue
+const webhookBatchSize = 50
+
+// WebhookRetryPolicy controls how failed deliveries are retried. The delay doubles after
+// every failed attempt, starting at BaseDelay and never exceeding MaxDelay.
+type WebhookRetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// DefaultWebhookRetryPolicy retries a delivery up to 8 times over roughly two hours
+func DefaultWebhookRetryPolicy() WebhookRetryPolicy {
+	return WebhookRetryPolicy{
+		MaxAttempts: 8,
+		BaseDelay:   30 * time.Second,
+		MaxDelay:    time.Hour,
+	}
+}
+
+// delay returns how long to wait before the next attempt after the given number of failed attempts
+func (p WebhookRetryPolicy) delay(attempts int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempts && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	return delay
+}
+
+// webhookEnvelope is the JSON body of every webhook delivery
+type webhookEnvelope struct {
+	Event      entities.WebhookEventType `json:"event"`
+	OccurredAt time.Time                 `json:"occurred_at"`
+	Data       interface{}               `json:"data"`
+}
+
+// WebhookService manages partner webhook subscriptions and delivers events to them.
+// Events are stored as pending deliveries first, so the queue survives restarts, and a
+// background worker sends them with HMAC-SHA256 signatures and exponential backoff.
+type WebhookService struct {
+	subscriptionRepo repositories.WebhookSubscriptionRepository
+	deliveryRepo     repositories.WebhookDeliveryRepository
+	client           *http.Client
+	retry            WebhookRetryPolicy
+	logger           logger.Logger
+	processing       sync.Mutex
+	wake             chan struct{}
+}
+
+// NewWebhookService creates a new webhook service instance
+func NewWebhookService(subscriptionRepo repositories.WebhookSubscriptionRepository, deliveryRepo repositories.WebhookDeliveryRepository, client *http.Client, retry WebhookRetryPolicy) *WebhookService {
+	return &WebhookService{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+		client:           client,
+		retry:            retry,
+		logger:           logger.NewLogger(),
+		wake:             make(chan struct{}, 1),
+	}
+}
+
+// CreateSubscription registers a partner endpoint. A secret is generated when none is given.
+func (s *WebhookService) CreateSubscription(subscription *entities.WebhookSubscription) error {
+	if subscription.Secret == "" {
+		secret, err := generateWebhookSecret()
+		if err != nil {
+			return err
+		}
+		subscription.Secret = secret
+	}
+
+	if err := validateWebhookSubscription(subscription); err != nil {
+		return err
+	}
+
+	subscription.ID = 0
+	return s.subscriptionRepo.Create(subscription)
+}
+
+// GetSubscriptionByID retrieves a webhook subscription by ID
+func (s *WebhookService) GetSubscriptionByID(id uint) (*entities.WebhookSubscription, error) {
+	if id == 0 {
+		return nil, errors.New("invalid webhook ID")
+	}
+
+	return s.subscriptionRepo.GetByID(id)
+}
+
+// GetAllSubscriptions retrieves all webhook subscriptions
+func (s *WebhookService) GetAllSubscriptions() ([]entities.WebhookSubscription, error) {
+	return s.subscriptionRepo.GetAll()
+}
+
+// UpdateSubscription updates a webhook subscription, keeping the current secret when none is given
+func (s *WebhookService) UpdateSubscription(subscription *entities.WebhookSubscription) error {
+	existing, err := s.GetSubscriptionByID(subscription.ID)
+	if err != nil {
+		return err
+	}
+
+	if subscription.Secret == "" {
+		subscription.Secret = existing.Secret
+	}
+
+	if err := validateWebhookSubscription(subscription); err != nil {
+		return err
+	}
+
+	subscription.CreatedAt = existing.CreatedAt
+	return s.subscriptionRepo.Save(subscription)
+}
+
+// RotateSecret replaces the secret of a webhook subscription with a newly generated one
+func (s *WebhookService) RotateSecret(id uint) (*entities.WebhookSubscription, error) {
+	subscription, err := s.GetSubscriptionByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+	subscription.Secret = secret
+
+	if err := s.subscriptionRepo.Save(subscription); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// DeleteSubscription deletes a webhook subscription and its delivery log
+func (s *WebhookService) DeleteSubscription(id uint) error {
+	if id == 0 {
+		return errors.New("invalid webhook ID")
+	}
+
+	return s.subscriptionRepo.Delete(id)
+}
+
+// GetDeliveries retrieves the delivery log of a webhook subscription
+func (s *WebhookService) GetDeliveries(subscriptionID uint) ([]entities.WebhookDelivery, error) {
+	if _, err := s.GetSubscriptionByID(subscriptionID); err != nil {
+		return nil, err
+	}
+
+	return s.deliveryRepo.GetBySubscriptionID(subscriptionID)
+}
+
+// MatchFinished queues a match.finished event; it is registered as a match finished hook.
+// The hook also runs when a finished match is corrected, so the event is keyed by match
+// and each subscription receives it once.
+func (s *WebhookService) MatchFinished(match *entities.Match) error {
+	return s.Publish(entities.WebhookEventMatchFinished, fmt.Sprintf("match:%d", match.ID), match)
+}
+
+// SeasonActivated queues a season.activated event; it is registered as a season activated hook
+func (s *WebhookService) SeasonActivated(season *entities.Season) error {
+	return s.Publish(entities.WebhookEventSeasonActivated, fmt.Sprintf("season:%d", season.ID), season)
+}
+
+// Publish queues a delivery of the event for every active subscription that wants it.
+// Subscriptions that already have a delivery for the same event type and key are skipped.
+func (s *WebhookService) Publish(eventType entities.WebhookEventType, eventKey string, data interface{}) error {
+	subscriptions, err := s.subscriptionRepo.GetActive()
+	if err != nil {
+		return err
+	}
+
+	published, err := s.deliveryRepo.GetByEvent(eventType, eventKey)
+	if err != nil {
+		return err
+	}
+	delivered := make(map[uint]bool, len(published))
+	for _, delivery := range published {
+		delivered[delivery.SubscriptionID] = true
+	}
+
+	now := time.Now()
+	payload, err := json.Marshal(webhookEnvelope{Event: eventType, OccurredAt: now, Data: data})
+	if err != nil {
+		return err
+	}
+
+	queued := false
+	for _, subscription := range subscriptions {
+		if !subscription.Subscribes(eventType) || delivered[subscription.ID] {
+			continue
+		}
+
+		delivery := &entities.WebhookDelivery{
+			SubscriptionID: subscription.ID,
+			EventType:      eventType,
+			EventKey:       eventKey,
+			Payload:        string(payload),
+			Status:         entities.WebhookDeliveryPending,
+			NextAttemptAt:  now,
+		}
+		if err := s.deliveryRepo.Create(delivery); err != nil {
+			return err
+		}
+		queued = true
+	}
+
+	if queued {
+		select {
+		case s.wake <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// Run processes the delivery queue until the context is cancelled. It wakes up every
+// interval to pick up retries and immediately when new events are published.
+func (s *WebhookService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.ProcessDue(time.Now()); err != nil {
+			s.logger.Error("Failed to process webhook deliveries: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.wake:
+		}
+	}
+}
+
+// ProcessDue attempts every pending delivery that is due at the given time and returns how many were attempted
+func (s *WebhookService) ProcessDue(now time.Time) (int, error) {
+	s.processing.Lock()
+	defer s.processing.Unlock()
+
+	attempted := 0
+	for {
+		deliveries, err := s.deliveryRepo.GetDue(now, webhookBatchSize)
+		if err != nil {
+			return attempted, err
+		}
+
+		for i := range deliveries {
+			if err := s.attempt(&deliveries[i], now); err != nil {
+				return attempted, err
+			}
+			attempted++
+		}
+
+		if len(deliveries) < webhookBatchSize {
+			return attempted, nil
+		}
+	}
+}
+
+// attempt sends a delivery once and schedules a retry or gives up when it fails. Deliveries
+// queued for a subscription that has since been disabled are given up without being sent.
+func (s *WebhookService) attempt(delivery *entities.WebhookDelivery, now time.Time) error {
+	subscription, err := s.subscriptionRepo.GetByID(delivery.SubscriptionID)
+	if err != nil || subscription.Disabled {
+		delivery.Status = entities.WebhookDeliveryFailed
+		delivery.LastError = "subscription not found"
+		if err == nil {
+			delivery.LastError = "subscription disabled"
+		}
+		return s.deliveryRepo.Save(delivery)
+	}
+
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+
+	status, err := s.send(subscription, delivery)
+	delivery.ResponseStatus = status
+	if err == nil {
+		delivery.Status = entities.WebhookDeliverySucceeded
+		delivery.LastError = ""
+		return s.deliveryRepo.Save(delivery)
+	}
+
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= s.retry.MaxAttempts {
+		delivery.Status = entities.WebhookDeliveryFailed
+		s.logger.Warn("Giving up webhook delivery %d to %s after %d attempts: %v", delivery.ID, subscription.URL, delivery.Attempts, err)
+	} else {
+		delivery.NextAttemptAt = now.Add(s.retry.delay(delivery.Attempts))
+	}
+	return s.deliveryRepo.Save(delivery)
+}
+
+// send posts the signed payload of a delivery and returns the response status code
+func (s *WebhookService) send(subscription *entities.WebhookSubscription, delivery *entities.WebhookDelivery) (int, error) {
+	request, err := http.NewRequest(http.MethodPost, subscription.URL, bytes.NewBufferString(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(WebhookEventHeader, string(delivery.EventType))
+	request.Header.Set(WebhookDeliveryHeader, strconv.FormatUint(uint64(delivery.ID), 10))
+	request.Header.Set(WebhookSignatureHeader, SignWebhookPayload(subscription.Secret, []byte(delivery.Payload)))
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(response.Body, 64<<10))
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return response.StatusCode, fmt.Errorf("endpoint responded with status %d", response.StatusCode)
+	}
+	return response.StatusCode, nil
+}
+
+// SignWebhookPayload returns the signature header value of a payload: sha256= followed by
+// the hex-encoded HMAC-SHA256 of the body keyed with the subscription secret
+func SignWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// generateWebhookSecret creates a random secret for a subscription
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+// validateWebhookSubscription checks the URL and the event types of a subscription
+func validateWebhookSubscription(subscription *entities.WebhookSubscription) error {
+	endpoint, err := url.Parse(subscription.URL)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return errors.New("webhook URL must be an absolute http or https URL")
+	}
+
+	if len(subscription.EventTypes) == 0 {
+		return errors.New("at least one event type is required")
+	}
+
+	for _, eventType := range subscription.EventTypes {
+		switch eventType {
+		case entities.WebhookEventMatchFinished, entities.WebhookEventSeasonActivated:
+		default:
+			return fmt.Errorf("unknown event type %q", eventType)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,305 @@
+package services
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// webhookReceiver is a local partner endpoint that records deliveries and fails the first ones
+type webhookReceiver struct {
+	mu       sync.Mutex
+	secret   string
+	failures int
+	received []webhookEnvelope
+	invalid  int
+}
+
+func (r *webhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	body, _ := io.ReadAll(req.Body)
+	if req.Header.Get(WebhookSignatureHeader) != SignWebhookPayload(r.secret, body) {
+		r.invalid++
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if r.failures > 0 {
+		r.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	var envelope webhookEnvelope
+	json.Unmarshal(body, &envelope)
+	if string(envelope.Event) != req.Header.Get(WebhookEventHeader) {
+		r.invalid++
+	}
+	r.received = append(r.received, envelope)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// newTestWebhookService creates a webhook service with a one-second base retry delay
+func newTestWebhookService(maxAttempts int) (*WebhookService, *MockWebhookDeliveryRepository) {
+	deliveryRepo := NewMockWebhookDeliveryRepository()
+	service := NewWebhookService(NewMockWebhookSubscriptionRepository(), deliveryRepo, http.DefaultClient, WebhookRetryPolicy{
+		MaxAttempts: maxAttempts,
+		BaseDelay:   time.Second,
+		MaxDelay:    time.Minute,
+	})
+	return service, deliveryRepo
+}
+
+// TestWebhookRetryPolicy_Delay tests the exponential backoff and its cap
+func TestWebhookRetryPolicy_Delay(t *testing.T) {
+	policy := WebhookRetryPolicy{MaxAttempts: 10, BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	want := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for i, delay := range want {
+		if got := policy.delay(i + 1); got != delay {
+			t.Errorf("delay(%d) = %v, want %v", i+1, got, delay)
+		}
+	}
+}
+
+// TestWebhookService_MatchFinishedWithRetries tests signed delivery of a finished match to a
+// local endpoint that fails twice before accepting it
+func TestWebhookService_MatchFinishedWithRetries(t *testing.T) {
+	receiver := &webhookReceiver{secret: "s3cret", failures: 2}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	service, _ := newTestWebhookService(5)
+	matches := &entities.WebhookSubscription{URL: server.URL, Secret: "s3cret", EventTypes: []entities.WebhookEventType{entities.WebhookEventMatchFinished}}
+	seasons := &entities.WebhookSubscription{URL: server.URL, Secret: "other", EventTypes: []entities.WebhookEventType{entities.WebhookEventSeasonActivated}}
+	for _, subscription := range []*entities.WebhookSubscription{matches, seasons} {
+		if err := service.CreateSubscription(subscription); err != nil {
+			t.Fatalf("CreateSubscription() error = %v", err)
+		}
+	}
+
+	repo := NewMockMatchRepository()
+	ruleSetService, _, _ := newTestRuleSetService()
+	matchService := NewMatchService(repo, ruleSetService)
+	matchService.OnMatchFinished(service.MatchFinished)
+	match := newFinishedMatch(1, 1, 2, 0, 0)
+	repo.Create(match)
+	if err := matchService.UpdateMatchScore(match.ID, 3, 1); err != nil {
+		t.Fatalf("UpdateMatchScore() error = %v", err)
+	}
+
+	now := time.Now()
+	steps := []struct {
+		at        time.Time
+		attempted int
+	}{
+		{at: now, attempted: 1},                             // 503
+		{at: now.Add(500 * time.Millisecond), attempted: 0}, // retry not due yet
+		{at: now.Add(time.Second), attempted: 1},            // 503
+		{at: now.Add(3 * time.Second), attempted: 1},        // 204
+		{at: now.Add(time.Hour), attempted: 0},              // nothing left
+	}
+	for i, step := range steps {
+		attempted, err := service.ProcessDue(step.at)
+		if err != nil {
+			t.Fatalf("ProcessDue() step %d error = %v", i, err)
+		}
+		if attempted != step.attempted {
+			t.Errorf("ProcessDue() step %d attempted %d deliveries, want %d", i, attempted, step.attempted)
+		}
+	}
+
+	if receiver.invalid != 0 || len(receiver.received) != 1 {
+		t.Fatalf("receiver got %d valid and %d invalid deliveries, want 1 and 0", len(receiver.received), receiver.invalid)
+	}
+	if receiver.received[0].Event != entities.WebhookEventMatchFinished {
+		t.Errorf("received event %q, want %q", receiver.received[0].Event, entities.WebhookEventMatchFinished)
+	}
+
+	deliveries, err := service.GetDeliveries(matches.ID)
+	if err != nil {
+		t.Fatalf("GetDeliveries() error = %v", err)
+	}
+	if len(deliveries) != 1 || deliveries[0].Status != entities.WebhookDeliverySucceeded || deliveries[0].Attempts != 3 {
+		t.Errorf("delivery log = %+v, want one succeeded delivery after 3 attempts", deliveries)
+	}
+	if deliveries, _ := service.GetDeliveries(seasons.ID); len(deliveries) != 0 {
+		t.Errorf("season subscription got %d deliveries for a match event", len(deliveries))
+	}
+}
+
+// TestWebhookService_MatchFinishedOnce tests that correcting a finished match does not queue the event again
+func TestWebhookService_MatchFinishedOnce(t *testing.T) {
+	service, deliveryRepo := newTestWebhookService(5)
+	subscription := &entities.WebhookSubscription{URL: "http://partner.example/hook", EventTypes: []entities.WebhookEventType{entities.WebhookEventMatchFinished}}
+	if err := service.CreateSubscription(subscription); err != nil {
+		t.Fatalf("CreateSubscription() error = %v", err)
+	}
+
+	repo := NewMockMatchRepository()
+	ruleSetService, _, _ := newTestRuleSetService()
+	matchService := NewMatchService(repo, ruleSetService)
+	matchService.OnMatchFinished(service.MatchFinished)
+	first := newFinishedMatch(1, 1, 2, 0, 0)
+	second := newFinishedMatch(1, 3, 4, 0, 0)
+	repo.Create(first)
+	repo.Create(second)
+	for _, score := range [][2]int{{1, 0}, {2, 0}, {2, 1}} {
+		if err := matchService.UpdateMatchScore(first.ID, score[0], score[1]); err != nil {
+			t.Fatalf("UpdateMatchScore() error = %v", err)
+		}
+	}
+	if err := matchService.UpdateMatchScore(second.ID, 0, 1); err != nil {
+		t.Fatalf("UpdateMatchScore() error = %v", err)
+	}
+
+	deliveries, _ := deliveryRepo.GetBySubscriptionID(subscription.ID)
+	if len(deliveries) != 2 {
+		t.Fatalf("queued %d deliveries, want one per match", len(deliveries))
+	}
+	if deliveries[0].EventKey != "match:1" || deliveries[1].EventKey != "match:2" {
+		t.Errorf("event keys = %q, %q, want match:1, match:2", deliveries[0].EventKey, deliveries[1].EventKey)
+	}
+}
+
+// TestWebhookService_SeasonActivatedGivesUp tests that a delivery is marked failed after the last attempt
+func TestWebhookService_SeasonActivatedGivesUp(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	service, _ := newTestWebhookService(2)
+	subscription := &entities.WebhookSubscription{URL: server.URL, EventTypes: []entities.WebhookEventType{entities.WebhookEventSeasonActivated}}
+	if err := service.CreateSubscription(subscription); err != nil {
+		t.Fatalf("CreateSubscription() error = %v", err)
+	}
+	if subscription.Secret == "" {
+		t.Errorf("a secret should be generated when none is given")
+	}
+
+	_, ruleSetRepo, seasonRepo := newTestRuleSetService()
+	seasonService := NewSeasonService(seasonRepo, ruleSetRepo, NewMockMatchRepository(), NewMockTeamMovementRepository())
+	seasonService.OnSeasonActivated(service.SeasonActivated)
+	if err := seasonService.ActivateSeason(1); err != nil {
+		t.Fatalf("ActivateSeason() error = %v", err)
+	}
+
+	now := time.Now()
+	service.ProcessDue(now)
+	service.ProcessDue(now.Add(time.Second))
+
+	deliveries, _ := service.GetDeliveries(subscription.ID)
+	if len(deliveries) != 1 {
+		t.Fatalf("got %d deliveries, want 1", len(deliveries))
+	}
+	delivery := deliveries[0]
+	if delivery.Status != entities.WebhookDeliveryFailed || delivery.Attempts != 2 || delivery.ResponseStatus != http.StatusInternalServerError {
+		t.Errorf("delivery = %s after %d attempts with status %d, want failed after 2 with 500", delivery.Status, delivery.Attempts, delivery.ResponseStatus)
+	}
+	if attempted, _ := service.ProcessDue(now.Add(time.Hour)); attempted != 0 {
+		t.Errorf("failed deliveries should not be retried")
+	}
+}
+
+// TestWebhookService_DisabledSubscription tests that retries queued before a subscription was
+// disabled are given up instead of being sent
+func TestWebhookService_DisabledSubscription(t *testing.T) {
+	receiver := &webhookReceiver{secret: "s3cret", failures: 1}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	service, _ := newTestWebhookService(5)
+	subscription := &entities.WebhookSubscription{URL: server.URL, Secret: "s3cret", EventTypes: []entities.WebhookEventType{entities.WebhookEventMatchFinished}}
+	if err := service.CreateSubscription(subscription); err != nil {
+		t.Fatalf("CreateSubscription() error = %v", err)
+	}
+	if err := service.MatchFinished(newFinishedMatch(1, 1, 2, 1, 0)); err != nil {
+		t.Fatalf("MatchFinished() error = %v", err)
+	}
+
+	now := time.Now()
+	service.ProcessDue(now)
+	subscription.Disabled = true
+	if err := service.UpdateSubscription(subscription); err != nil {
+		t.Fatalf("UpdateSubscription() error = %v", err)
+	}
+	service.ProcessDue(now.Add(time.Hour))
+
+	if len(receiver.received) != 0 {
+		t.Errorf("receiver got %d deliveries after the subscription was disabled", len(receiver.received))
+	}
+	deliveries, _ := service.GetDeliveries(subscription.ID)
+	if len(deliveries) != 1 || deliveries[0].Status != entities.WebhookDeliveryFailed || deliveries[0].Attempts != 1 || deliveries[0].LastError != "subscription disabled" {
+		t.Errorf("delivery log = %+v, want one failed delivery after 1 attempt", deliveries)
+	}
+}
+
+// TestWebhookService_Secrets tests that a given secret is used, kept by updates without one,
+// replaced by updates with one and rotated on request
+func TestWebhookService_Secrets(t *testing.T) {
+	service, _ := newTestWebhookService(3)
+	events := []entities.WebhookEventType{entities.WebhookEventMatchFinished}
+	subscription := &entities.WebhookSubscription{URL: "https://partner.example/hooks", Secret: "given", EventTypes: events}
+	if err := service.CreateSubscription(subscription); err != nil {
+		t.Fatalf("CreateSubscription() error = %v", err)
+	}
+
+	secret := func() string {
+		stored, _ := service.GetSubscriptionByID(subscription.ID)
+		return stored.Secret
+	}
+	if secret() != "given" {
+		t.Errorf("secret = %q, want the given one", secret())
+	}
+
+	if err := service.UpdateSubscription(&entities.WebhookSubscription{ID: subscription.ID, URL: "https://partner.example/v2", EventTypes: events}); err != nil {
+		t.Fatalf("UpdateSubscription() error = %v", err)
+	}
+	if secret() != "given" {
+		t.Errorf("secret after an update without one = %q, want it kept", secret())
+	}
+
+	if err := service.UpdateSubscription(&entities.WebhookSubscription{ID: subscription.ID, URL: "https://partner.example/v2", Secret: "replaced", EventTypes: events}); err != nil {
+		t.Fatalf("UpdateSubscription() error = %v", err)
+	}
+	if secret() != "replaced" {
+		t.Errorf("secret after an update with one = %q, want replaced", secret())
+	}
+
+	rotated, err := service.RotateSecret(subscription.ID)
+	if err != nil {
+		t.Fatalf("RotateSecret() error = %v", err)
+	}
+	if rotated.Secret == "replaced" || rotated.Secret == "" || secret() != rotated.Secret {
+		t.Errorf("rotated secret = %q, stored %q, want a new stored secret", rotated.Secret, secret())
+	}
+}
+
+// TestWebhookService_Validation tests that invalid subscriptions are rejected
+func TestWebhookService_Validation(t *testing.T) {
+	service, _ := newTestWebhookService(3)
+	events := []entities.WebhookEventType{entities.WebhookEventMatchFinished}
+
+	tests := []struct {
+		name         string
+		subscription entities.WebhookSubscription
+	}{
+		{name: "relative URL", subscription: entities.WebhookSubscription{URL: "/hooks", EventTypes: events}},
+		{name: "unsupported scheme", subscription: entities.WebhookSubscription{URL: "ftp://partner.example/hooks", EventTypes: events}},
+		{name: "no event types", subscription: entities.WebhookSubscription{URL: "https://partner.example/hooks"}},
+		{name: "unknown event type", subscription: entities.WebhookSubscription{URL: "https://partner.example/hooks", EventTypes: []entities.WebhookEventType{"team.created"}}},
+	}
+
+	for _, tt := range tests {
+		if err := service.CreateSubscription(&tt.subscription); err == nil {
+			t.Errorf("CreateSubscription() with %s should fail", tt.name)
+		}
+	}
+}
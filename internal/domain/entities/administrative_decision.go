@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"time"
+)
+
+// AdministrativeResult identifies a match result decided off the pitch
+type AdministrativeResult string
+
+const (
+	AdministrativeForfeit  AdministrativeResult = "forfeit"
+	AdministrativeWalkover AdministrativeResult = "walkover"
+	AdministrativeAwarded  AdministrativeResult = "awarded"
+)
+
+// IsValid reports whether the result is one of the known administrative results
+func (r AdministrativeResult) IsValid() bool {
+	switch r {
+	case AdministrativeForfeit, AdministrativeWalkover, AdministrativeAwarded:
+		return true
+	}
+	return false
+}
+
+// AdministrativeDecision records an administrative result applied to a match, the score
+// it was settled with and who applied it
+type AdministrativeDecision struct {
+	ID           uint                 `json:"id" gorm:"primaryKey;autoIncrement"`
+	MatchID      uint                 `json:"match_id" gorm:"not null;index"`
+	Result       AdministrativeResult `json:"result" gorm:"size:32;not null"`
+	WinnerTeamID uint                 `json:"winner_team_id" gorm:"not null"`
+	HomeScore    int                  `json:"home_score" gorm:"type:int;not null"`
+	AwayScore    int                  `json:"away_score" gorm:"type:int;not null"`
+	Reason       string               `json:"reason" gorm:"type:text;not null"`
+	AppliedBy    string               `json:"applied_by" gorm:"size:255;not null"`
+	AppliedAt    time.Time            `json:"applied_at" gorm:"autoCreateTime"`
+}
+
+// TableName specifies the table name for AdministrativeDecision
+func (AdministrativeDecision) TableName() string {
+	return "administrative_decision"
+}
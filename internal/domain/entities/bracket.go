@@ -0,0 +1,19 @@
+package entities
+
+// BracketNode represents a knockout match slot and the slots that feed into it.
+// Match is nil while the slot is still waiting for its feeder matches to finish.
+// A slot played over two legs shows its first leg and the aggregate score of the tie.
+type BracketNode struct {
+	Stage   MatchStage    `json:"stage"`
+	Slot    int           `json:"slot"`
+	Match   *Match        `json:"match,omitempty"`
+	Tie     *TieResult    `json:"tie,omitempty"`
+	Feeders []BracketNode `json:"feeders,omitempty"`
+}
+
+// Bracket represents the knockout tree of a season.
+type Bracket struct {
+	SeasonID   uint         `json:"seasonId"`
+	Final      BracketNode  `json:"final"`
+	ThirdPlace *BracketNode `json:"thirdPlace,omitempty"`
+}
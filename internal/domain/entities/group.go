@@ -0,0 +1,49 @@
+package entities
+
+import (
+	"time"
+)
+
+// Group represents a group of teams playing each other in the group stage of a season
+type Group struct {
+	ID        uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	SeasonID  uint      `json:"season_id" gorm:"not null;uniqueIndex:idx_group_season_name"`
+	Name      string    `json:"name" gorm:"size:255;not null;uniqueIndex:idx_group_season_name"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+
+	// Relationships
+	Season Season `json:"-" gorm:"foreignKey:SeasonID"`
+	Teams  []Team `json:"teams" gorm:"many2many:group_team;"`
+}
+
+// TableName specifies the table name for Group; "group" is a reserved word in SQL
+func (Group) TableName() string {
+	return "season_group"
+}
+
+// HasTeam reports whether a team has been assigned to the group
+func (g *Group) HasTeam(teamID uint) bool {
+	for _, team := range g.Teams {
+		if team.ID == teamID {
+			return true
+		}
+	}
+	return false
+}
+
+// TeamIDs returns the IDs of the teams assigned to the group
+func (g *Group) TeamIDs() []uint {
+	ids := make([]uint, 0, len(g.Teams))
+	for _, team := range g.Teams {
+		ids = append(ids, team.ID)
+	}
+	return ids
+}
+
+// GroupStandings holds the leaderboard of a single group
+type GroupStandings struct {
+	GroupID     uint        `json:"groupId"`
+	Name        string      `json:"name"`
+	Leaderboard Leaderboard `json:"leaderboard"`
+}
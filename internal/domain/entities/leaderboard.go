@@ -1,6 +1,22 @@
 package entities
 
+import (
+	"time"
+)
+
+// Venue selects the matches a leaderboard counts for each team
+type Venue string
+
+const (
+	VenueAll  Venue = ""
+	VenueHome Venue = "home"
+	VenueAway Venue = "away"
+)
+
 // LeaderboardEntry represents a single team's standing in the leaderboard.
+// Points are the points earned in matches less the points deducted by sanctions.
+// Form lists the latest results as W, D or L, oldest first, and the streaks count
+// the consecutive wins, matches without defeat and defeats up to the latest match.
 type LeaderboardEntry struct {
 	TeamID         uint   `json:"teamId"`
 	TeamName       string `json:"teamName"`
@@ -11,8 +27,24 @@ type LeaderboardEntry struct {
 	GoalsFor       int    `json:"goalsFor"`
 	GoalsAgainst   int    `json:"goalsAgainst"`
 	GoalDifference int    `json:"goalDifference"`
+	PointsDeducted int    `json:"pointsDeducted"`
 	Points         int    `json:"points"`
+	Form           string `json:"form"`
+	WinningStreak  int    `json:"winningStreak"`
+	UnbeatenStreak int    `json:"unbeatenStreak"`
+	LosingStreak   int    `json:"losingStreak"`
 }
 
 // Leaderboard represents the entire table of standings for a season.
 type Leaderboard []LeaderboardEntry
+
+// StandingsSnapshot is the leaderboard of a season after a round, or after a match day
+// when the matches have no rounds. Date is the date of the last match counted.
+type StandingsSnapshot struct {
+	Round       int         `json:"round,omitempty"`
+	Date        time.Time   `json:"date"`
+	Leaderboard Leaderboard `json:"leaderboard"`
+}
+
+// StandingsHistory lists the snapshots of the standings of a season in order.
+type StandingsHistory []StandingsSnapshot
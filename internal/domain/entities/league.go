@@ -9,6 +9,7 @@ type League struct {
 	ID        uint      `json:"id" gorm:"primaryKey;autoIncrement"`
 	Name      string    `json:"name" gorm:"size:255;not null"`
 	BirthDate time.Time `json:"birth_date" gorm:"type:timestamp"`
+	Tier      int       `json:"tier" gorm:"type:int;default:0"` // 1 is the top division, 0 means untiered
 	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
 	
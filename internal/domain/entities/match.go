@@ -10,11 +10,37 @@ type MatchStatus string
 const (
 	MatchStatusScheduled  MatchStatus = "scheduled"
 	MatchStatusInProgress MatchStatus = "in_progress"
+	MatchStatusHalfTime   MatchStatus = "half_time"
 	MatchStatusFinished   MatchStatus = "finished"
 	MatchStatusPostponed  MatchStatus = "postponed"
 	MatchStatusCancelled  MatchStatus = "cancelled"
+	MatchStatusAbandoned  MatchStatus = "abandoned"
 )
 
+// matchStatusTransitions lists the statuses a match can move to from each status.
+// Finished, cancelled and abandoned matches are final.
+var matchStatusTransitions = map[MatchStatus][]MatchStatus{
+	MatchStatusScheduled:  {MatchStatusInProgress, MatchStatusPostponed, MatchStatusCancelled},
+	MatchStatusInProgress: {MatchStatusHalfTime, MatchStatusFinished, MatchStatusAbandoned},
+	MatchStatusHalfTime:   {MatchStatusInProgress, MatchStatusAbandoned},
+	MatchStatusPostponed:  {MatchStatusScheduled, MatchStatusCancelled},
+}
+
+// CanTransitionTo reports whether a match in this status can move to the next status
+func (s MatchStatus) CanTransitionTo(next MatchStatus) bool {
+	for _, allowed := range matchStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsLive reports whether the match is being played
+func (s MatchStatus) IsLive() bool {
+	return s == MatchStatusInProgress || s == MatchStatusHalfTime
+}
+
 // MatchStage represents the stage of a match in the tournament
 type MatchStage string
 
@@ -28,30 +54,61 @@ const (
 
 // Match represents a soccer match entity
 type Match struct {
-	ID             uint       `json:"id" gorm:"primaryKey;autoIncrement"`
-	HomeTeamID     uint       `json:"home_team_id" gorm:"not null"`
-	AwayTeamID     uint       `json:"away_team_id" gorm:"not null"`
-	SeasonID       uint       `json:"season_id" gorm:"not null"`
-	StadiumID      uint       `json:"stadium_id" gorm:"not null"`
-	Date           time.Time  `json:"date" gorm:"type:timestamp;not null"`
-	Hour           *int       `json:"hour" gorm:"type:int"`
-	HomeTeamScore  *int       `json:"home_team_score" gorm:"type:int"`
-	AwayTeamScore  *int       `json:"away_team_score" gorm:"type:int"`
-	HomeTeamPoints *int       `json:"home_team_points" gorm:"type:int"`
-	AwayTeamPoints *int       `json:"away_team_points" gorm:"type:int"`
-	Stage          MatchStage `json:"stage" gorm:"size:255;not null"`
-	Observation    string     `json:"observation" gorm:"type:text"`
-	Status         string     `json:"status"`
-	Round          int        `json:"round"`
-	CreatedAt      time.Time  `json:"createdAt"`
-	UpdatedAt      time.Time  `json:"updatedAt"`
+	ID             uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	HomeTeamID     uint      `json:"home_team_id" gorm:"not null"`
+	AwayTeamID     uint      `json:"away_team_id" gorm:"not null"`
+	SeasonID       uint      `json:"season_id" gorm:"not null"`
+	StadiumID      uint      `json:"stadium_id" gorm:"not null"`
+	Date           time.Time `json:"date" gorm:"type:timestamp;not null"`
+	Hour           *int      `json:"hour" gorm:"type:int"`
+	HomeTeamScore  *int      `json:"home_team_score" gorm:"type:int"`
+	AwayTeamScore  *int      `json:"away_team_score" gorm:"type:int"`
+	HomeTeamPoints *int      `json:"home_team_points" gorm:"type:int"`
+	AwayTeamPoints *int      `json:"away_team_points" gorm:"type:int"`
+
+	// Knockout deciders: goals scored during extra time only and the penalty shootout score,
+	// which is counted from PenaltyKicks. HomeTeamScore and AwayTeamScore always hold the
+	// regulation time result.
+	HomeExtraTimeScore *int `json:"home_extra_time_score" gorm:"type:int"`
+	AwayExtraTimeScore *int `json:"away_extra_time_score" gorm:"type:int"`
+	HomePenaltyScore   *int `json:"home_penalty_score" gorm:"type:int"`
+	AwayPenaltyScore   *int `json:"away_penalty_score" gorm:"type:int"`
+
+	// Administrative result the match was settled with instead of the score on the pitch,
+	// and why; the decisions and who applied them are kept as AdministrativeDecisions
+	AdministrativeResult AdministrativeResult `json:"administrative_result,omitempty" gorm:"size:32;not null;default:''"`
+	AdministrativeReason string               `json:"administrative_reason,omitempty" gorm:"type:text"`
+
+	Stage       MatchStage `json:"stage" gorm:"size:255;not null"`
+	Observation string     `json:"observation" gorm:"type:text"`
+	Status      string     `json:"status"`
+	Round       int        `json:"round"`
+	BracketSlot int        `json:"bracket_slot"`
+
+	// Lifecycle timestamps, set when the match enters each phase
+	KickedOffAt         *time.Time `json:"kicked_off_at"`
+	HalfTimeAt          *time.Time `json:"half_time_at"`
+	SecondHalfStartedAt *time.Time `json:"second_half_started_at"`
+	FinishedAt          *time.Time `json:"finished_at"`
+	PostponedAt         *time.Time `json:"postponed_at"`
+	CancelledAt         *time.Time `json:"cancelled_at"`
+	AbandonedAt         *time.Time `json:"abandoned_at"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
 
 	// Relationships
-	HomeTeam    Team          `json:"home_team,omitempty" gorm:"foreignKey:HomeTeamID"`
-	AwayTeam    Team          `json:"away_team,omitempty" gorm:"foreignKey:AwayTeamID"`
-	Season      Season        `json:"season,omitempty" gorm:"foreignKey:SeasonID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL,name:fk_matches_season"`
-	Stadium     Stadium       `json:"stadium,omitempty" gorm:"foreignKey:StadiumID"`
-	PlayerStats []MatchPlayer `json:"player_stats,omitempty" gorm:"foreignKey:MatchID"`
+	HomeTeam     Team          `json:"home_team,omitempty" gorm:"foreignKey:HomeTeamID"`
+	AwayTeam     Team          `json:"away_team,omitempty" gorm:"foreignKey:AwayTeamID"`
+	Season       Season        `json:"season,omitempty" gorm:"foreignKey:SeasonID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL,name:fk_matches_season"`
+	Stadium      Stadium       `json:"stadium,omitempty" gorm:"foreignKey:StadiumID"`
+	PlayerStats  []MatchPlayer `json:"player_stats,omitempty" gorm:"foreignKey:MatchID"`
+	PenaltyKicks []PenaltyKick `json:"penalty_kicks,omitempty" gorm:"foreignKey:MatchID"`
+}
+
+// IsAdministrative reports whether the result of the match was decided off the pitch
+func (m *Match) IsAdministrative() bool {
+	return m.AdministrativeResult != ""
 }
 
 // TableName specifies the table name for Match
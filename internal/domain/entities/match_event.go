@@ -0,0 +1,48 @@
+package entities
+
+import (
+	"time"
+)
+
+// MatchEventType defines the kinds of events recorded during a match
+type MatchEventType string
+
+const (
+	MatchEventGoal         MatchEventType = "goal"
+	MatchEventPenaltyGoal  MatchEventType = "penalty_goal"
+	MatchEventOwnGoal      MatchEventType = "own_goal"
+	MatchEventYellowCard   MatchEventType = "yellow_card"
+	MatchEventRedCard      MatchEventType = "red_card"
+	MatchEventSubstitution MatchEventType = "substitution"
+)
+
+// MatchEvent represents a single event on the timeline of a match.
+// For goals the secondary player is the one who gave the assist; for
+// substitutions the player comes on and the secondary player goes off.
+// TeamID is always the team of the player, also for own goals.
+type MatchEvent struct {
+	ID                uint           `json:"id" gorm:"primaryKey;autoIncrement"`
+	MatchID           uint           `json:"match_id" gorm:"not null;index"`
+	TeamID            uint           `json:"team_id" gorm:"not null"`
+	PlayerID          uint           `json:"player_id" gorm:"not null"`
+	SecondaryPlayerID *uint          `json:"secondary_player_id"`
+	Type              MatchEventType `json:"type" gorm:"size:50;not null"`
+	Minute            int            `json:"minute" gorm:"type:int;not null"`
+	AddedTime         int            `json:"added_time" gorm:"type:int;default:0"`
+	CreatedAt         time.Time      `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt         time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
+
+	// Relationships
+	Player          Player  `json:"player,omitempty" gorm:"foreignKey:PlayerID"`
+	SecondaryPlayer *Player `json:"secondary_player,omitempty" gorm:"foreignKey:SecondaryPlayerID"`
+}
+
+// IsGoal reports whether the event changes the score
+func (e *MatchEvent) IsGoal() bool {
+	return e.Type == MatchEventGoal || e.Type == MatchEventPenaltyGoal || e.Type == MatchEventOwnGoal
+}
+
+// TableName specifies the table name for MatchEvent
+func (MatchEvent) TableName() string {
+	return "match_event"
+}
@@ -13,6 +13,8 @@ type MatchPlayer struct {
 	RedCard    int       `json:"red_card" gorm:"type:int;default:0"`
 	YellowCard int       `json:"yellow_card" gorm:"type:int;default:0"`
 	Goals      int       `json:"goals" gorm:"type:int;default:0"`
+	Assists    int       `json:"assists" gorm:"type:int;default:0"`
+	OwnGoals   int       `json:"own_goals" gorm:"type:int;default:0"`
 	CreatedAt  time.Time `json:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt  time.Time `json:"updated_at" gorm:"autoUpdateTime"`
 	
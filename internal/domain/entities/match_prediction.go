@@ -0,0 +1,27 @@
+package entities
+
+// MatchPrediction holds the pre-match outcome probabilities of a match, predicted with a
+// Poisson model fitted to the completed matches of its season
+type MatchPrediction struct {
+	MatchID            uint                   `json:"match_id"`
+	HomeTeamID         uint                   `json:"home_team_id"`
+	AwayTeamID         uint                   `json:"away_team_id"`
+	HomeAttack         float64                `json:"home_attack"`
+	HomeDefence        float64                `json:"home_defence"`
+	AwayAttack         float64                `json:"away_attack"`
+	AwayDefence        float64                `json:"away_defence"`
+	HomeExpectedGoals  float64                `json:"home_expected_goals"`
+	AwayExpectedGoals  float64                `json:"away_expected_goals"`
+	HomeWinProbability float64                `json:"home_win_probability"`
+	DrawProbability    float64                `json:"draw_probability"`
+	AwayWinProbability float64                `json:"away_win_probability"`
+	MatchesUsed        int                    `json:"matches_used"`
+	Scorelines         []ScorelineProbability `json:"scorelines"`
+}
+
+// ScorelineProbability is the probability of a match ending with a given score
+type ScorelineProbability struct {
+	HomeGoals   int     `json:"home_goals"`
+	AwayGoals   int     `json:"away_goals"`
+	Probability float64 `json:"probability"`
+}
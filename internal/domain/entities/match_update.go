@@ -0,0 +1,22 @@
+package entities
+
+import "time"
+
+// MatchUpdateType identifies what changed in a match update
+type MatchUpdateType string
+
+const (
+	MatchUpdateScore       MatchUpdateType = "score"
+	MatchUpdateStatus      MatchUpdateType = "status"
+	MatchUpdatePlayerStats MatchUpdateType = "player_stats"
+)
+
+// MatchUpdate is a change to a match pushed to live subscribers
+type MatchUpdate struct {
+	Type        MatchUpdateType `json:"type"`
+	MatchID     uint            `json:"match_id"`
+	SeasonID    uint            `json:"season_id"`
+	Match       *Match          `json:"match,omitempty"`
+	PlayerStats *MatchPlayer    `json:"player_stats,omitempty"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+}
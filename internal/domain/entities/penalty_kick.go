@@ -0,0 +1,16 @@
+package entities
+
+// PenaltyKick represents a single kick of a penalty shootout
+type PenaltyKick struct {
+	ID       uint `json:"id" gorm:"primaryKey;autoIncrement"`
+	MatchID  uint `json:"match_id" gorm:"not null;index"`
+	Order    int  `json:"order" gorm:"column:kick_order;not null"`
+	TeamID   uint `json:"team_id" gorm:"not null"`
+	PlayerID uint `json:"player_id" gorm:"not null"`
+	Scored   bool `json:"scored"`
+}
+
+// TableName specifies the table name for PenaltyKick
+func (PenaltyKick) TableName() string {
+	return "penalty_kick"
+}
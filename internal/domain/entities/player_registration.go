@@ -0,0 +1,54 @@
+package entities
+
+import (
+	"time"
+)
+
+// PlayerRegistration records a spell of a player at a team during a season. The registration
+// that has no end date is the player's current one; Player.TeamID mirrors its team.
+type PlayerRegistration struct {
+	ID          uint       `json:"id" gorm:"primaryKey;autoIncrement"`
+	PlayerID    uint       `json:"player_id" gorm:"not null;index"`
+	TeamID      uint       `json:"team_id" gorm:"not null;index"`
+	SeasonID    uint       `json:"season_id" gorm:"not null;index"`
+	From        time.Time  `json:"from" gorm:"type:timestamp;not null"`
+	To          *time.Time `json:"to" gorm:"type:timestamp"`
+	ShirtNumber int        `json:"shirt_number" gorm:"type:int;not null"`
+	CreatedAt   time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+
+	// Relationships
+	Team   Team   `json:"team,omitempty" gorm:"foreignKey:TeamID"`
+	Season Season `json:"season,omitempty" gorm:"foreignKey:SeasonID"`
+}
+
+// TableName specifies the table name for PlayerRegistration
+func (PlayerRegistration) TableName() string {
+	return "player_registration"
+}
+
+// ActiveOn reports whether the registration covers the given moment
+func (r *PlayerRegistration) ActiveOn(at time.Time) bool {
+	return !at.Before(r.From) && (r.To == nil || at.Before(*r.To))
+}
+
+// TransferWindow is a period of a season during which players may be registered or transferred
+type TransferWindow struct {
+	ID        uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	SeasonID  uint      `json:"season_id" gorm:"not null;index"`
+	Name      string    `json:"name" gorm:"size:255"`
+	OpensAt   time.Time `json:"opens_at" gorm:"type:timestamp;not null"`
+	ClosesAt  time.Time `json:"closes_at" gorm:"type:timestamp;not null"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for TransferWindow
+func (TransferWindow) TableName() string {
+	return "transfer_window"
+}
+
+// Contains reports whether the window is open at the given moment
+func (w *TransferWindow) Contains(at time.Time) bool {
+	return !at.Before(w.OpensAt) && !at.After(w.ClosesAt)
+}
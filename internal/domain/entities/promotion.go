@@ -0,0 +1,50 @@
+package entities
+
+import (
+	"time"
+)
+
+// PromotionRule links a league to the league one tier below it and sets how many teams move
+// between them at the end of a season. With Playoff set, the winner of the lower league's
+// knockout final takes one extra promotion place and one extra team is relegated.
+type PromotionRule struct {
+	ID             uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	UpperLeagueID  uint      `json:"upper_league_id" gorm:"not null;uniqueIndex"`
+	LowerLeagueID  uint      `json:"lower_league_id" gorm:"not null;uniqueIndex"`
+	PromotedTeams  int       `json:"promoted_teams" gorm:"type:int;not null"`
+	RelegatedTeams int       `json:"relegated_teams" gorm:"type:int;not null"`
+	Playoff        bool      `json:"playoff" gorm:"not null"`
+	CreatedAt      time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt      time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for PromotionRule
+func (PromotionRule) TableName() string {
+	return "promotion_rule"
+}
+
+// MovementDirection tells whether a team moves up or down a tier
+type MovementDirection string
+
+const (
+	MovementPromoted  MovementDirection = "promoted"
+	MovementRelegated MovementDirection = "relegated"
+)
+
+// TeamMovement records a team leaving the league of a completed season for the linked league
+type TeamMovement struct {
+	ID           uint              `json:"id" gorm:"primaryKey;autoIncrement"`
+	SeasonID     uint              `json:"season_id" gorm:"not null;index"`
+	TeamID       uint              `json:"team_id" gorm:"not null"`
+	FromLeagueID uint              `json:"from_league_id" gorm:"not null"`
+	ToLeagueID   uint              `json:"to_league_id" gorm:"not null;index"`
+	Direction    MovementDirection `json:"direction" gorm:"size:32;not null"`
+	Position     int               `json:"position" gorm:"type:int"`
+	ViaPlayoff   bool              `json:"via_playoff" gorm:"not null"`
+	CreatedAt    time.Time         `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// TableName specifies the table name for TeamMovement
+func (TeamMovement) TableName() string {
+	return "team_movement"
+}
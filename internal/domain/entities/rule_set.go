@@ -0,0 +1,113 @@
+package entities
+
+import (
+	"time"
+)
+
+// Tiebreaker identifies a criterion used to order teams that are level on points
+type Tiebreaker string
+
+const (
+	TiebreakerHeadToHead     Tiebreaker = "head_to_head"
+	TiebreakerGoalDifference Tiebreaker = "goal_difference"
+	TiebreakerGoalsFor       Tiebreaker = "goals_for"
+	TiebreakerAwayGoals      Tiebreaker = "away_goals"
+	TiebreakerWins           Tiebreaker = "wins"
+	TiebreakerFairPlay       Tiebreaker = "fair_play"
+)
+
+// Default discipline rules: five yellow cards or a red card mean a one-match ban
+const (
+	DefaultYellowCardThreshold  = 5
+	DefaultYellowCardBanMatches = 1
+	DefaultRedCardBanMatches    = 1
+)
+
+// DefaultAwardedGoals is the score of the winner of a forfeit, walkover or awarded match; the loser gets none
+const DefaultAwardedGoals = 3
+
+// RuleSet represents the points, tiebreaker and discipline rules of a league or a single season.
+// Exactly one of LeagueID or SeasonID is set; season rules override league rules.
+type RuleSet struct {
+	ID            uint         `json:"id" gorm:"primaryKey;autoIncrement"`
+	LeagueID      *uint        `json:"league_id" gorm:"uniqueIndex"`
+	SeasonID      *uint        `json:"season_id" gorm:"uniqueIndex"`
+	PointsForWin  int          `json:"points_for_win" gorm:"type:int;not null"`
+	PointsForDraw int          `json:"points_for_draw" gorm:"type:int;not null"`
+	PointsForLoss int          `json:"points_for_loss" gorm:"type:int;not null"`
+	Tiebreakers   []Tiebreaker `json:"tiebreakers" gorm:"type:text;serializer:json"`
+
+	// Discipline rules; unset values fall back to the defaults and a ban of zero matches
+	// means the cards are not punished with a ban
+	YellowCardThreshold  *int `json:"yellow_card_threshold" gorm:"type:int"`
+	YellowCardBanMatches *int `json:"yellow_card_ban_matches" gorm:"type:int"`
+	RedCardBanMatches    *int `json:"red_card_ban_matches" gorm:"type:int"`
+
+	// Goals awarded to the winner of an administrative result; unset falls back to the default
+	AwardedGoals *int      `json:"awarded_goals" gorm:"type:int"`
+	CreatedAt    time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// DefaultRuleSet returns the rules used when neither the season nor its league define any
+func DefaultRuleSet() *RuleSet {
+	return &RuleSet{
+		PointsForWin:  3,
+		PointsForDraw: 1,
+		PointsForLoss: 0,
+		Tiebreakers:   []Tiebreaker{TiebreakerGoalDifference, TiebreakerGoalsFor},
+
+		YellowCardThreshold:  intPointer(DefaultYellowCardThreshold),
+		YellowCardBanMatches: intPointer(DefaultYellowCardBanMatches),
+		RedCardBanMatches:    intPointer(DefaultRedCardBanMatches),
+
+		AwardedGoals: intPointer(DefaultAwardedGoals),
+	}
+}
+
+// intPointer returns a pointer to a copy of value
+func intPointer(value int) *int {
+	return &value
+}
+
+// Discipline returns the number of yellow cards that trigger a ban and the length of the
+// bans for accumulated yellow cards and for a red card, applying the defaults for unset values
+func (r *RuleSet) Discipline() (yellowThreshold, yellowBan, redBan int) {
+	yellowThreshold, yellowBan, redBan = DefaultYellowCardThreshold, DefaultYellowCardBanMatches, DefaultRedCardBanMatches
+	if r.YellowCardThreshold != nil {
+		yellowThreshold = *r.YellowCardThreshold
+	}
+	if r.YellowCardBanMatches != nil {
+		yellowBan = *r.YellowCardBanMatches
+	}
+	if r.RedCardBanMatches != nil {
+		redBan = *r.RedCardBanMatches
+	}
+	return yellowThreshold, yellowBan, redBan
+}
+
+// AwardedScore returns the goals awarded to the winner of an administrative result,
+// applying the default when unset
+func (r *RuleSet) AwardedScore() int {
+	if r.AwardedGoals == nil {
+		return DefaultAwardedGoals
+	}
+	return *r.AwardedGoals
+}
+
+// Points returns the points a team earns for a result with the given score
+func (r *RuleSet) Points(goalsFor, goalsAgainst int) int {
+	switch {
+	case goalsFor > goalsAgainst:
+		return r.PointsForWin
+	case goalsFor < goalsAgainst:
+		return r.PointsForLoss
+	default:
+		return r.PointsForDraw
+	}
+}
+
+// TableName specifies the table name for RuleSet
+func (RuleSet) TableName() string {
+	return "rule_set"
+}
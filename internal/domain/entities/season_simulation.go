@@ -0,0 +1,24 @@
+package entities
+
+// SeasonSimulation holds the chances of every team to finish a season in each position,
+// estimated by playing out the remaining matches of the season many times
+type SeasonSimulation struct {
+	SeasonID         uint             `json:"season_id"`
+	Runs             int              `json:"runs"`
+	Seed             int64            `json:"seed"`
+	RemainingMatches int              `json:"remaining_matches"`
+	Teams            []TeamSimulation `json:"teams"`
+}
+
+// TeamSimulation holds the simulated final standings of a team. PositionProbabilities lists
+// the probability of finishing first, second and so on; the current position is 0 for a
+// team that has not played yet.
+type TeamSimulation struct {
+	TeamID                uint      `json:"team_id"`
+	TeamName              string    `json:"team_name"`
+	CurrentPosition       int       `json:"current_position"`
+	CurrentPoints         int       `json:"current_points"`
+	ExpectedPoints        float64   `json:"expected_points"`
+	ExpectedPosition      float64   `json:"expected_position"`
+	PositionProbabilities []float64 `json:"position_probabilities"`
+}
@@ -0,0 +1,28 @@
+package entities
+
+// SuspensionReason identifies why a player was suspended
+type SuspensionReason string
+
+const (
+	SuspensionReasonYellowCards SuspensionReason = "yellow_cards"
+	SuspensionReasonRedCard     SuspensionReason = "red_card"
+)
+
+// Suspension is a ban derived from the cards a player received in a season. It is served
+// by sitting out the next finished matches of the team the player was booked for.
+type Suspension struct {
+	PlayerID       uint             `json:"player_id"`
+	TeamID         uint             `json:"team_id"`
+	SeasonID       uint             `json:"season_id"`
+	Reason         SuspensionReason `json:"reason"`
+	TriggerMatchID uint             `json:"trigger_match_id"`
+	Matches        int              `json:"matches"`
+	Served         int              `json:"served"`
+	ServedMatchIDs []uint           `json:"served_match_ids"`
+	Active         bool             `json:"active"`
+}
+
+// Remaining returns the number of matches still to be served
+func (s *Suspension) Remaining() int {
+	return s.Matches - s.Served
+}
@@ -0,0 +1,33 @@
+package entities
+
+import (
+	"time"
+)
+
+// TeamRating is the Elo rating of a team after one of its finished matches
+type TeamRating struct {
+	ID           uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	TeamID       uint      `json:"team_id" gorm:"not null;index"`
+	MatchID      uint      `json:"match_id" gorm:"not null;index"`
+	SeasonID     uint      `json:"season_id" gorm:"not null"`
+	OpponentID   uint      `json:"opponent_id" gorm:"not null"`
+	Date         time.Time `json:"date" gorm:"type:timestamp;not null"`
+	RatingBefore float64   `json:"rating_before" gorm:"not null"`
+	Rating       float64   `json:"rating" gorm:"not null"`
+	CreatedAt    time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// TableName specifies the table name for TeamRating
+func (TeamRating) TableName() string {
+	return "team_rating"
+}
+
+// TeamRanking is the position of a team when teams are ranked by their current rating.
+// Teams without rated matches have the initial rating and no last match date.
+type TeamRanking struct {
+	Position      int        `json:"position"`
+	TeamID        uint       `json:"team_id"`
+	TeamName      string     `json:"team_name"`
+	Rating        float64    `json:"rating"`
+	LastMatchDate *time.Time `json:"last_match_date"`
+}
@@ -0,0 +1,24 @@
+package entities
+
+import (
+	"time"
+)
+
+// TeamSanction represents a points deduction imposed on a team for a season, for example
+// for fielding an ineligible player in a match or for unpaid fees
+type TeamSanction struct {
+	ID             uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	SeasonID       uint      `json:"season_id" gorm:"not null;index"`
+	TeamID         uint      `json:"team_id" gorm:"not null"`
+	MatchID        *uint     `json:"match_id"`
+	PointsDeducted int       `json:"points_deducted" gorm:"type:int;not null"`
+	Reason         string    `json:"reason" gorm:"type:text;not null"`
+	Date           time.Time `json:"date" gorm:"type:timestamp;not null"`
+	CreatedAt      time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt      time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for TeamSanction
+func (TeamSanction) TableName() string {
+	return "team_sanction"
+}
@@ -0,0 +1,122 @@
+package entities
+
+import (
+	"time"
+)
+
+// TieDecision describes what settled a two-legged tie
+type TieDecision string
+
+const (
+	TieDecidedOnAggregate TieDecision = "aggregate"
+	TieDecidedOnAwayGoals TieDecision = "away_goals"
+	TieDecidedInExtraTime TieDecision = "extra_time"
+	TieDecidedOnPenalties TieDecision = "penalties"
+)
+
+// Tie links the two legs of a knockout pairing played home and away. The second leg hosts
+// the extra time and the penalty shootout when the aggregate score is level.
+type Tie struct {
+	ID            uint       `json:"id" gorm:"primaryKey;autoIncrement"`
+	SeasonID      uint       `json:"season_id" gorm:"not null;index"`
+	Stage         MatchStage `json:"stage" gorm:"size:255;not null"`
+	BracketSlot   int        `json:"bracket_slot"`
+	FirstLegID    uint       `json:"first_leg_id" gorm:"not null;uniqueIndex"`
+	SecondLegID   uint       `json:"second_leg_id" gorm:"not null;uniqueIndex"`
+	AwayGoalsRule bool       `json:"away_goals_rule"`
+	CreatedAt     time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+
+	// Relationships
+	FirstLeg  Match `json:"first_leg" gorm:"foreignKey:FirstLegID"`
+	SecondLeg Match `json:"second_leg" gorm:"foreignKey:SecondLegID"`
+}
+
+// TableName specifies the table name for Tie
+func (Tie) TableName() string {
+	return "tie"
+}
+
+// TieResult holds the aggregate score of a tie. The home team is the one hosting the first leg.
+type TieResult struct {
+	Tie           *Tie        `json:"tie"`
+	HomeTeamID    uint        `json:"home_team_id"`
+	AwayTeamID    uint        `json:"away_team_id"`
+	HomeAggregate int         `json:"home_aggregate"`
+	AwayAggregate int         `json:"away_aggregate"`
+	HomeAwayGoals int         `json:"home_away_goals"`
+	AwayAwayGoals int         `json:"away_away_goals"`
+	Decided       bool        `json:"decided"`
+	WinnerID      uint        `json:"winner_id,omitempty"`
+	LoserID       uint        `json:"loser_id,omitempty"`
+	DecidedBy     TieDecision `json:"decided_by,omitempty"`
+}
+
+// Result computes the aggregate score of the tie from its loaded legs. A level aggregate is
+// settled by away goals when the rule applies, then by the extra time and the penalty
+// shootout of the second leg. Away goals scored in extra time count as away goals.
+func (t *Tie) Result() TieResult {
+	first, second := &t.FirstLeg, &t.SecondLeg
+	result := TieResult{
+		Tie:        t,
+		HomeTeamID: first.HomeTeamID,
+		AwayTeamID: first.AwayTeamID,
+	}
+
+	if first.HomeTeamScore != nil && first.AwayTeamScore != nil {
+		result.HomeAggregate += *first.HomeTeamScore
+		result.AwayAggregate += *first.AwayTeamScore
+		result.AwayAwayGoals += *first.AwayTeamScore
+	}
+
+	if MatchStatus(first.Status) != MatchStatusFinished || MatchStatus(second.Status) != MatchStatusFinished ||
+		second.HomeTeamScore == nil || second.AwayTeamScore == nil {
+		return result
+	}
+
+	// The home team of the first leg is the away team of the second leg
+	result.HomeAggregate += *second.AwayTeamScore
+	result.AwayAggregate += *second.HomeTeamScore
+	result.HomeAwayGoals += *second.AwayTeamScore
+	if result.decide(t.AwayGoalsRule, TieDecidedOnAggregate) {
+		return result
+	}
+
+	if second.HomeExtraTimeScore != nil && second.AwayExtraTimeScore != nil {
+		result.HomeAggregate += *second.AwayExtraTimeScore
+		result.AwayAggregate += *second.HomeExtraTimeScore
+		result.HomeAwayGoals += *second.AwayExtraTimeScore
+		if result.decide(t.AwayGoalsRule, TieDecidedInExtraTime) {
+			return result
+		}
+	}
+
+	if second.HomePenaltyScore != nil && second.AwayPenaltyScore != nil && *second.HomePenaltyScore != *second.AwayPenaltyScore {
+		result.DecidedBy = TieDecidedOnPenalties
+		result.setWinner(*second.AwayPenaltyScore > *second.HomePenaltyScore)
+	}
+
+	return result
+}
+
+// decide settles the tie on the aggregate score, or on away goals when the rule applies
+func (r *TieResult) decide(awayGoalsRule bool, decision TieDecision) bool {
+	switch {
+	case r.HomeAggregate != r.AwayAggregate:
+		r.DecidedBy = decision
+		r.setWinner(r.HomeAggregate > r.AwayAggregate)
+	case awayGoalsRule && r.HomeAwayGoals != r.AwayAwayGoals:
+		r.DecidedBy = TieDecidedOnAwayGoals
+		r.setWinner(r.HomeAwayGoals > r.AwayAwayGoals)
+	}
+	return r.Decided
+}
+
+// setWinner records the winner and loser of the tie
+func (r *TieResult) setWinner(homeWins bool) {
+	r.Decided = true
+	r.WinnerID, r.LoserID = r.AwayTeamID, r.HomeTeamID
+	if homeWins {
+		r.WinnerID, r.LoserID = r.HomeTeamID, r.AwayTeamID
+	}
+}
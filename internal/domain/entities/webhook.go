@@ -0,0 +1,75 @@
+package entities
+
+import (
+	"time"
+)
+
+// WebhookEventType identifies an event partners can subscribe to
+type WebhookEventType string
+
+const (
+	WebhookEventMatchFinished   WebhookEventType = "match.finished"
+	WebhookEventSeasonActivated WebhookEventType = "season.activated"
+)
+
+// WebhookDeliveryStatus represents the state of a webhook delivery in the retry queue
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookSubscription is a partner endpoint notified about the events it subscribed to.
+// Every delivery is signed with the secret so the partner can verify where it came from;
+// the secret is never serialized and is only handed out when subscribing and when it is
+// rotated. Disabled
+// subscriptions keep their delivery log but receive no new events.
+type WebhookSubscription struct {
+	ID         uint               `json:"id" gorm:"primaryKey;autoIncrement"`
+	URL        string             `json:"url" gorm:"size:2048;not null"`
+	Secret     string             `json:"-" gorm:"size:255;not null"`
+	EventTypes []WebhookEventType `json:"event_types" gorm:"type:text;serializer:json"`
+	Disabled   bool               `json:"disabled" gorm:"not null"`
+	CreatedAt  time.Time          `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time          `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// Subscribes reports whether the subscription wants deliveries for the event type
+func (w *WebhookSubscription) Subscribes(eventType WebhookEventType) bool {
+	for _, t := range w.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// TableName specifies the table name for WebhookSubscription
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscription"
+}
+
+// WebhookDelivery is one event sent to one subscription. Pending deliveries form the
+// persistent retry queue and record every attempt made so far.
+type WebhookDelivery struct {
+	ID             uint                  `json:"id" gorm:"primaryKey;autoIncrement"`
+	SubscriptionID uint                  `json:"subscription_id" gorm:"not null;index"`
+	EventType      WebhookEventType      `json:"event_type" gorm:"size:64;not null"`
+	EventKey       string                `json:"event_key" gorm:"size:128;index"`
+	Payload        string                `json:"payload" gorm:"type:text;not null"`
+	Status         WebhookDeliveryStatus `json:"status" gorm:"size:32;not null;index"`
+	Attempts       int                   `json:"attempts" gorm:"type:int;not null"`
+	NextAttemptAt  time.Time             `json:"next_attempt_at" gorm:"type:timestamp;index"`
+	LastAttemptAt  *time.Time            `json:"last_attempt_at" gorm:"type:timestamp"`
+	ResponseStatus int                   `json:"response_status" gorm:"type:int"`
+	LastError      string                `json:"last_error" gorm:"type:text"`
+	CreatedAt      time.Time             `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt      time.Time             `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for WebhookDelivery
+func (WebhookDelivery) TableName() string {
+	return "webhook_delivery"
+}
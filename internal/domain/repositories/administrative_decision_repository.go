@@ -0,0 +1,10 @@
+package repositories
+
+import "catalyst-players/internal/domain/entities"
+
+// AdministrativeDecisionRepository defines the interface for administrative decision data operations.
+// Apply saves the settled match and records the decision in one transaction.
+type AdministrativeDecisionRepository interface {
+	Apply(match *entities.Match, decision *entities.AdministrativeDecision) error
+	GetByMatchID(matchID uint) ([]entities.AdministrativeDecision, error)
+}
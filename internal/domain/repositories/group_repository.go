@@ -0,0 +1,14 @@
+package repositories
+
+import "catalyst-players/internal/domain/entities"
+
+// GroupRepository defines the interface for group data operations.
+// Groups are always loaded together with their teams.
+type GroupRepository interface {
+	Create(group *entities.Group) error
+	GetByID(id uint) (*entities.Group, error)
+	GetBySeasonID(seasonID uint) ([]entities.Group, error)
+	Delete(id uint) error
+	ReplaceTeams(groupID uint, teamIDs []uint) error
+	ReplaceForSeason(seasonID uint, groups []entities.Group) error
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import "catalyst-players/internal/domain/entities"
+
+// MatchEventRepository defines the interface for match event data operations. Save creates
+// or updates an event and Remove deletes one; both store the player statistics and the
+// result of the match derived from the new timeline in the same transaction.
+type MatchEventRepository interface {
+	Save(event *entities.MatchEvent, stats []entities.MatchPlayer, match *entities.Match) error
+	GetByID(id uint) (*entities.MatchEvent, error)
+	Remove(id uint, stats []entities.MatchPlayer, match *entities.Match) error
+	GetByMatchID(matchID uint) ([]entities.MatchEvent, error)
+}
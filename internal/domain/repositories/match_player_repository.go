@@ -13,4 +13,5 @@ type MatchPlayerRepository interface {
 	GetByPlayerID(playerID uint) ([]entities.MatchPlayer, error)
 	GetByTeamID(teamID uint) ([]entities.MatchPlayer, error)
 	GetPlayerStats(playerID uint, seasonID uint) ([]entities.MatchPlayer, error)
+	GetBySeasonID(seasonID uint) ([]entities.MatchPlayer, error)
 } 
\ No newline at end of file
@@ -5,9 +5,12 @@ import (
 	"time"
 )
 
-// MatchRepository defines the interface for match data operations
+// MatchRepository defines the interface for match data operations. SaveResult stores the
+// status, score, points and knockout deciders of a match, including cleared ones, and
+// replaces its penalty kicks in the same transaction when replaceKicks is set.
 type MatchRepository interface {
 	Create(match *entities.Match) error
+	CreateBatch(matches []entities.Match) error
 	GetByID(id uint) (*entities.Match, error)
 	GetAll() ([]entities.Match, error)
 	Update(match *entities.Match) error
@@ -20,4 +23,6 @@ type MatchRepository interface {
 	GetUpcoming(limit int) ([]entities.Match, error)
 	GetLive() ([]entities.Match, error)
 	GetCompleted(seasonID uint) ([]entities.Match, error)
+	GetFinished() ([]entities.Match, error)
+	SaveResult(match *entities.Match, replaceKicks bool) error
 }
@@ -0,0 +1,22 @@
+package repositories
+
+import "catalyst-players/internal/domain/entities"
+
+// PlayerRegistrationRepository defines the interface for player registration data operations.
+// Transfer saves the spell a player leaves, when given, creates the new registration and
+// moves the player to its team and shirt number in a single transaction. Register creates a
+// new player together with their first registration in a single transaction.
+type PlayerRegistrationRepository interface {
+	Transfer(player *entities.Player, previous, registration *entities.PlayerRegistration) error
+	Register(player *entities.Player, registration *entities.PlayerRegistration) error
+	GetByPlayerID(playerID uint) ([]entities.PlayerRegistration, error)
+	GetBySeasonAndTeam(seasonID, teamID uint) ([]entities.PlayerRegistration, error)
+}
+
+// TransferWindowRepository defines the interface for transfer window data operations
+type TransferWindowRepository interface {
+	Create(window *entities.TransferWindow) error
+	GetByID(id uint) (*entities.TransferWindow, error)
+	GetBySeasonID(seasonID uint) ([]entities.TransferWindow, error)
+	Delete(id uint) error
+}
@@ -0,0 +1,19 @@
+package repositories
+
+import "catalyst-players/internal/domain/entities"
+
+// PromotionRuleRepository defines the interface for promotion rule data operations.
+// The Find methods return nil without an error when no rule links the league.
+type PromotionRuleRepository interface {
+	Save(rule *entities.PromotionRule) error
+	Delete(id uint) error
+	FindByUpperLeagueID(leagueID uint) (*entities.PromotionRule, error)
+	FindByLowerLeagueID(leagueID uint) (*entities.PromotionRule, error)
+}
+
+// TeamMovementRepository defines the interface for team movement data operations
+type TeamMovementRepository interface {
+	ReplaceForSeason(seasonID uint, movements []entities.TeamMovement) error
+	GetBySeasonID(seasonID uint) ([]entities.TeamMovement, error)
+	GetByToLeagueID(leagueID uint) ([]entities.TeamMovement, error)
+}
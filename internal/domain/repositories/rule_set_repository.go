@@ -0,0 +1,12 @@
+package repositories
+
+import "catalyst-players/internal/domain/entities"
+
+// RuleSetRepository defines the interface for rule set data operations.
+// The Find methods return nil without an error when no rule set has been defined.
+type RuleSetRepository interface {
+	Save(ruleSet *entities.RuleSet) error
+	Delete(id uint) error
+	FindByLeagueID(leagueID uint) (*entities.RuleSet, error)
+	FindBySeasonID(seasonID uint) (*entities.RuleSet, error)
+}
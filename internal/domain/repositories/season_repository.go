@@ -15,4 +15,8 @@ type SeasonRepository interface {
 	GetWithMatches(id uint) (*entities.Season, error)
 	GetActiveSeasons() ([]entities.Season, error)
 	GetByLeagueID(leagueID uint) ([]entities.Season, error)
+	AddTeam(seasonID uint, teamID uint) error
+	RemoveTeam(seasonID uint, teamID uint) error
+	ReplaceTeams(seasonID uint, teamIDs []uint) error
+	CreateWithSetup(season *entities.Season, ruleSet *entities.RuleSet, matches []entities.Match) error
 }
@@ -0,0 +1,13 @@
+package repositories
+
+import "catalyst-players/internal/domain/entities"
+
+// TeamRatingRepository defines the interface for team rating data operations.
+// Ratings are stored in the order the matches were played.
+type TeamRatingRepository interface {
+	Create(ratings []entities.TeamRating) error
+	ReplaceAll(ratings []entities.TeamRating) error
+	GetByTeamID(teamID uint) ([]entities.TeamRating, error)
+	GetByMatchID(matchID uint) ([]entities.TeamRating, error)
+	GetLatest() ([]entities.TeamRating, error)
+}
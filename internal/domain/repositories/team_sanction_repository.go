@@ -0,0 +1,12 @@
+package repositories
+
+import "catalyst-players/internal/domain/entities"
+
+// TeamSanctionRepository defines the interface for team sanction data operations
+type TeamSanctionRepository interface {
+	Create(sanction *entities.TeamSanction) error
+	GetByID(id uint) (*entities.TeamSanction, error)
+	GetBySeasonID(seasonID uint) ([]entities.TeamSanction, error)
+	Save(sanction *entities.TeamSanction) error
+	Delete(id uint) error
+}
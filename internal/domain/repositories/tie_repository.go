@@ -0,0 +1,14 @@
+package repositories
+
+import "catalyst-players/internal/domain/entities"
+
+// TieRepository defines the interface for tie data operations.
+// Ties are always loaded together with both legs.
+type TieRepository interface {
+	Create(tie *entities.Tie) error
+	GetByID(id uint) (*entities.Tie, error)
+	GetBySeasonID(seasonID uint) ([]entities.Tie, error)
+	// FindByMatchID returns the tie a match is a leg of, or nil if it is a single match
+	FindByMatchID(matchID uint) (*entities.Tie, error)
+	Delete(id uint) error
+}
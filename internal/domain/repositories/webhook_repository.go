@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"time"
+)
+
+// WebhookSubscriptionRepository defines the interface for webhook subscription data operations
+type WebhookSubscriptionRepository interface {
+	Create(subscription *entities.WebhookSubscription) error
+	GetByID(id uint) (*entities.WebhookSubscription, error)
+	GetAll() ([]entities.WebhookSubscription, error)
+	GetActive() ([]entities.WebhookSubscription, error)
+	Save(subscription *entities.WebhookSubscription) error
+	Delete(id uint) error
+}
+
+// WebhookDeliveryRepository defines the interface for webhook delivery data operations
+type WebhookDeliveryRepository interface {
+	Create(delivery *entities.WebhookDelivery) error
+	Save(delivery *entities.WebhookDelivery) error
+	GetDue(now time.Time, limit int) ([]entities.WebhookDelivery, error)
+	GetBySubscriptionID(subscriptionID uint) ([]entities.WebhookDelivery, error)
+	GetByEvent(eventType entities.WebhookEventType, eventKey string) ([]entities.WebhookDelivery, error)
+}
@@ -0,0 +1,120 @@
+package broker
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/infrastructure/logger"
+	"sync"
+)
+
+// DefaultBufferSize is the number of updates a subscriber can fall behind before it is evicted
+const DefaultBufferSize = 64
+
+// Filter selects the updates a subscriber receives
+type Filter func(update entities.MatchUpdate) bool
+
+// Subscriber receives the match updates accepted by its filter
+type Subscriber struct {
+	id      uint64
+	filter  Filter
+	updates chan entities.MatchUpdate
+	evicted bool
+}
+
+// Updates returns the channel the subscriber reads from. It is closed when the
+// subscriber unsubscribes or is evicted for falling too far behind.
+func (s *Subscriber) Updates() <-chan entities.MatchUpdate {
+	return s.updates
+}
+
+// Broker is an in-process publish/subscribe hub for live match updates.
+// Publishing never blocks: every subscriber has its own buffer and a subscriber
+// whose buffer is full is evicted instead of slowing down the publisher.
+type Broker struct {
+	mu          sync.Mutex
+	logger      logger.Logger
+	bufferSize  int
+	nextID      uint64
+	subscribers map[uint64]*Subscriber
+}
+
+// NewBroker creates a broker with the given per-subscriber buffer size
+func NewBroker(bufferSize int) *Broker {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+
+	return &Broker{
+		logger:      logger.NewLogger(),
+		bufferSize:  bufferSize,
+		subscribers: make(map[uint64]*Subscriber),
+	}
+}
+
+// Subscribe registers a subscriber for the updates accepted by filter; a nil filter accepts all updates
+func (b *Broker) Subscribe(filter Filter) *Subscriber {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.nextID++
+	sub := &Subscriber{
+		id:      b.nextID,
+		filter:  filter,
+		updates: make(chan entities.MatchUpdate, b.bufferSize),
+	}
+	b.subscribers[sub.id] = sub
+	return sub
+}
+
+// Unsubscribe removes a subscriber and closes its channel. It is safe to call after an eviction.
+func (b *Broker) Unsubscribe(sub *Subscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.remove(sub)
+}
+
+// Evicted reports whether the subscriber was dropped for being too slow
+func (b *Broker) Evicted(sub *Subscriber) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return sub.evicted
+}
+
+// Publish delivers an update to every matching subscriber without blocking
+func (b *Broker) Publish(update entities.MatchUpdate) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, sub := range b.subscribers {
+		if sub.filter != nil && !sub.filter(update) {
+			continue
+		}
+
+		select {
+		case sub.updates <- update:
+		default:
+			b.logger.Warn("Evicting slow subscriber %d after %d buffered updates", sub.id, b.bufferSize)
+			sub.evicted = true
+			b.remove(sub)
+		}
+	}
+}
+
+// Subscribers returns the number of active subscribers
+func (b *Broker) Subscribers() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.subscribers)
+}
+
+// remove drops a subscriber and closes its channel; the caller must hold the lock
+func (b *Broker) remove(sub *Subscriber) {
+	if _, ok := b.subscribers[sub.id]; !ok {
+		return
+	}
+
+	delete(b.subscribers, sub.id)
+	close(sub.updates)
+}
@@ -0,0 +1,64 @@
+package broker
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"testing"
+)
+
+// TestBroker_FilteredDelivery tests that subscribers only receive the updates their filter accepts
+func TestBroker_FilteredDelivery(t *testing.T) {
+	b := NewBroker(4)
+	all := b.Subscribe(nil)
+	matchTwo := b.Subscribe(func(update entities.MatchUpdate) bool { return update.MatchID == 2 })
+
+	b.Publish(entities.MatchUpdate{Type: entities.MatchUpdateScore, MatchID: 1})
+	b.Publish(entities.MatchUpdate{Type: entities.MatchUpdateStatus, MatchID: 2})
+
+	if got := len(all.Updates()); got != 2 {
+		t.Errorf("unfiltered subscriber has %d updates, want 2", got)
+	}
+	if got := len(matchTwo.Updates()); got != 1 {
+		t.Fatalf("filtered subscriber has %d updates, want 1", got)
+	}
+	if update := <-matchTwo.Updates(); update.MatchID != 2 || update.Type != entities.MatchUpdateStatus {
+		t.Errorf("filtered subscriber got %+v", update)
+	}
+
+	b.Unsubscribe(matchTwo)
+	b.Unsubscribe(matchTwo)
+	if _, open := <-matchTwo.Updates(); open {
+		t.Errorf("channel should be closed after unsubscribe")
+	}
+	if b.Subscribers() != 1 {
+		t.Errorf("broker has %d subscribers, want 1", b.Subscribers())
+	}
+}
+
+// TestBroker_EvictsSlowConsumer tests that a full buffer evicts the subscriber instead of blocking
+func TestBroker_EvictsSlowConsumer(t *testing.T) {
+	b := NewBroker(2)
+	slow := b.Subscribe(nil)
+	fast := b.Subscribe(nil)
+
+	for i := uint(1); i <= 3; i++ {
+		b.Publish(entities.MatchUpdate{Type: entities.MatchUpdateScore, MatchID: i})
+		if i <= 2 {
+			<-fast.Updates()
+		}
+	}
+
+	if !b.Evicted(slow) || b.Evicted(fast) {
+		t.Fatalf("only the slow subscriber should be evicted")
+	}
+
+	received := 0
+	for range slow.Updates() {
+		received++
+	}
+	if received != 2 {
+		t.Errorf("slow subscriber drained %d buffered updates, want 2", received)
+	}
+	if update := <-fast.Updates(); update.MatchID != 3 {
+		t.Errorf("fast subscriber got match %d, want 3", update.MatchID)
+	}
+}
@@ -7,7 +7,7 @@ import (
 
 func TestNewLogger(t *testing.T) {
 	// Test development mode
-	os.Setenv("APP_ENV", "development")
+	t.Setenv("APP_ENV", "development")
 	logger := NewLogger()
 
 	// Test info logging
@@ -18,8 +18,18 @@ func TestNewLogger(t *testing.T) {
 }
 
 func TestNewLoggerProduction(t *testing.T) {
+	// Write the log files to a temporary directory instead of the source tree
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Getwd() error = %v", err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatalf("Chdir() error = %v", err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+
 	// Test production mode
-	os.Setenv("APP_ENV", "production")
+	t.Setenv("APP_ENV", "production")
 	logger := NewLogger()
 
 	// Test info logging
@@ -0,0 +1,47 @@
+package repositories
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"catalyst-players/internal/infrastructure/logger"
+
+	"gorm.io/gorm"
+)
+
+// AdministrativeDecisionRepositoryImpl implements the AdministrativeDecisionRepository interface using GORM
+type AdministrativeDecisionRepositoryImpl struct {
+	db     *gorm.DB
+	logger logger.Logger
+}
+
+// NewAdministrativeDecisionRepositoryImpl creates a new administrative decision repository implementation
+func NewAdministrativeDecisionRepositoryImpl(db *gorm.DB) repositories.AdministrativeDecisionRepository {
+	return &AdministrativeDecisionRepositoryImpl{
+		db:     db,
+		logger: logger.NewLogger(),
+	}
+}
+
+// Apply saves the result of a match settled off the pitch and records the decision
+func (r *AdministrativeDecisionRepositoryImpl) Apply(match *entities.Match, decision *entities.AdministrativeDecision) error {
+	r.logger.Info("Recording %s decision for match ID: %d by %s", decision.Result, decision.MatchID, decision.AppliedBy)
+	columns := append([]string{"administrative_result", "administrative_reason"}, matchResultColumns...)
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(match).Select(columns).Updates(match).Error; err != nil {
+			return err
+		}
+		return tx.Create(decision).Error
+	})
+	if err != nil {
+		r.logger.Error("Failed to record administrative decision: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetByMatchID retrieves the administrative decisions applied to a match, oldest first
+func (r *AdministrativeDecisionRepositoryImpl) GetByMatchID(matchID uint) ([]entities.AdministrativeDecision, error) {
+	var decisions []entities.AdministrativeDecision
+	err := r.db.Where("match_id = ?", matchID).Order("applied_at ASC, id ASC").Find(&decisions).Error
+	return decisions, err
+}
@@ -0,0 +1,108 @@
+package repositories
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"catalyst-players/internal/infrastructure/logger"
+
+	"gorm.io/gorm"
+)
+
+// GroupRepositoryImpl implements the GroupRepository interface using GORM
+type GroupRepositoryImpl struct {
+	db     *gorm.DB
+	logger logger.Logger
+}
+
+// NewGroupRepositoryImpl creates a new group repository implementation
+func NewGroupRepositoryImpl(db *gorm.DB) repositories.GroupRepository {
+	return &GroupRepositoryImpl{
+		db:     db,
+		logger: logger.NewLogger(),
+	}
+}
+
+// Create creates a new group together with its team assignments, leaving the teams untouched
+func (r *GroupRepositoryImpl) Create(group *entities.Group) error {
+	r.logger.Info("Creating group %s in season %d", group.Name, group.SeasonID)
+	err := r.db.Omit("Teams.*").Create(group).Error
+	if err != nil {
+		r.logger.Error("Failed to create group: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetByID retrieves a group by ID with its teams
+func (r *GroupRepositoryImpl) GetByID(id uint) (*entities.Group, error) {
+	var group entities.Group
+	err := r.db.Preload("Teams").First(&group, id).Error
+	if err != nil {
+		r.logger.Error("Failed to retrieve group with ID %d: %v", id, err)
+		return nil, err
+	}
+	return &group, nil
+}
+
+// GetBySeasonID retrieves the groups of a season with their teams, ordered by name
+func (r *GroupRepositoryImpl) GetBySeasonID(seasonID uint) ([]entities.Group, error) {
+	var groups []entities.Group
+	err := r.db.Preload("Teams").Where("season_id = ?", seasonID).Order("name ASC").Find(&groups).Error
+	if err != nil {
+		r.logger.Error("Failed to retrieve groups for season %d: %v", seasonID, err)
+		return nil, err
+	}
+	return groups, nil
+}
+
+// Delete deletes a group and its team assignments
+func (r *GroupRepositoryImpl) Delete(id uint) error {
+	return r.db.Select("Teams").Delete(&entities.Group{ID: id}).Error
+}
+
+// ReplaceTeams sets the teams assigned to a group in a single transaction
+func (r *GroupRepositoryImpl) ReplaceTeams(groupID uint, teamIDs []uint) error {
+	r.logger.Info("Replacing the teams of group %d with %d teams", groupID, len(teamIDs))
+	teams := make([]entities.Team, 0, len(teamIDs))
+	for _, teamID := range teamIDs {
+		teams = append(teams, entities.Team{ID: teamID})
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		group := &entities.Group{ID: groupID}
+		if len(teams) == 0 {
+			return tx.Model(group).Association("Teams").Clear()
+		}
+		return tx.Omit("Teams.*").Model(group).Association("Teams").Replace(teams)
+	})
+	if err != nil {
+		r.logger.Error("Failed to replace the teams of group %d: %v", groupID, err)
+		return err
+	}
+	return nil
+}
+
+// ReplaceForSeason stores the groups of a season, with their teams, in place of the existing ones
+func (r *GroupRepositoryImpl) ReplaceForSeason(seasonID uint, groups []entities.Group) error {
+	r.logger.Info("Replacing the groups of season %d with %d groups", seasonID, len(groups))
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var existing []entities.Group
+		if err := tx.Where("season_id = ?", seasonID).Find(&existing).Error; err != nil {
+			return err
+		}
+		for i := range existing {
+			if err := tx.Select("Teams").Delete(&existing[i]).Error; err != nil {
+				return err
+			}
+		}
+		if len(groups) == 0 {
+			return nil
+		}
+		return tx.Omit("Teams.*").Create(&groups).Error
+	})
+	if err != nil {
+		r.logger.Error("Failed to replace the groups of season %d: %v", seasonID, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package repositories
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"catalyst-players/internal/infrastructure/logger"
+
+	"gorm.io/gorm"
+)
+
+// MatchEventRepositoryImpl implements the MatchEventRepository interface using GORM
+type MatchEventRepositoryImpl struct {
+	db     *gorm.DB
+	logger logger.Logger
+}
+
+// NewMatchEventRepositoryImpl creates a new match event repository implementation
+func NewMatchEventRepositoryImpl(db *gorm.DB) repositories.MatchEventRepository {
+	return &MatchEventRepositoryImpl{
+		db:     db,
+		logger: logger.NewLogger(),
+	}
+}
+
+// Save creates a match event, or updates every editable column of an existing one so
+// optional fields can be cleared, together with the statistics and result of its match
+func (r *MatchEventRepositoryImpl) Save(event *entities.MatchEvent, stats []entities.MatchPlayer, match *entities.Match) error {
+	r.logger.Info("Saving match event for match ID: %d", match.ID)
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if event.ID == 0 {
+			if err := tx.Create(event).Error; err != nil {
+				return err
+			}
+		} else if err := tx.Model(event).
+			Select("TeamID", "PlayerID", "SecondaryPlayerID", "Type", "Minute", "AddedTime").
+			Updates(event).Error; err != nil {
+			return err
+		}
+		return saveTimelineResult(tx, stats, match)
+	})
+	if err != nil {
+		r.logger.Error("Failed to save match event for match ID %d: %v", match.ID, err)
+		return err
+	}
+	return nil
+}
+
+// GetByID retrieves a match event by ID
+func (r *MatchEventRepositoryImpl) GetByID(id uint) (*entities.MatchEvent, error) {
+	var event entities.MatchEvent
+	err := r.db.First(&event, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// Remove deletes a match event together with the statistics and result of its match
+func (r *MatchEventRepositoryImpl) Remove(id uint, stats []entities.MatchPlayer, match *entities.Match) error {
+	r.logger.Info("Removing match event with ID: %d", id)
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&entities.MatchEvent{}, id).Error; err != nil {
+			return err
+		}
+		return saveTimelineResult(tx, stats, match)
+	})
+	if err != nil {
+		r.logger.Error("Failed to remove match event with ID %d: %v", id, err)
+		return err
+	}
+	return nil
+}
+
+// saveTimelineResult stores the player statistics and the result derived from a match timeline
+func saveTimelineResult(tx *gorm.DB, stats []entities.MatchPlayer, match *entities.Match) error {
+	if err := syncMatchStats(tx, match.ID, stats); err != nil {
+		return err
+	}
+	return tx.Model(match).Select(matchResultColumns).Updates(match).Error
+}
+
+// GetByMatchID retrieves the timeline of a match in chronological order
+func (r *MatchEventRepositoryImpl) GetByMatchID(matchID uint) ([]entities.MatchEvent, error) {
+	var events []entities.MatchEvent
+	err := r.db.Preload("Player").
+		Preload("SecondaryPlayer").
+		Where("match_id = ?", matchID).
+		Order("minute ASC, added_time ASC, id ASC").
+		Find(&events).Error
+	return events, err
+}
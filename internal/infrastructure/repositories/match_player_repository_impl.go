@@ -65,15 +65,76 @@ func (r *MatchPlayerRepositoryImpl) GetByTeamID(teamID uint) ([]entities.MatchPl
 	return matchPlayers, err
 }
 
-// GetPlayerStats retrieves player statistics for a specific season
+// GetPlayerStats retrieves player statistics for a specific season, leaving out matches
+// settled by an administrative result
 func (r *MatchPlayerRepositoryImpl) GetPlayerStats(playerID uint, seasonID uint) ([]entities.MatchPlayer, error) {
 	var matchPlayers []entities.MatchPlayer
 	err := r.db.Joins("JOIN `match` ON `match`.id = match_player.match_id").
-		Where("match_player.player_id = ? AND `match`.season_id = ?", playerID, seasonID).
+		Where("match_player.player_id = ? AND `match`.season_id = ? AND `match`.administrative_result = ''", playerID, seasonID).
 		Find(&matchPlayers).Error
 	return matchPlayers, err
 }
 
+// GetBySeasonID retrieves all player statistics for the matches of a season
+func (r *MatchPlayerRepositoryImpl) GetBySeasonID(seasonID uint) ([]entities.MatchPlayer, error) {
+	var matchPlayers []entities.MatchPlayer
+	err := r.db.Joins("JOIN `match` ON `match`.id = match_player.match_id").
+		Where("`match`.season_id = ?", seasonID).
+		Find(&matchPlayers).Error
+	return matchPlayers, err
+}
+
+// syncMatchStats replaces the statistics of a match within a transaction. Existing rows are
+// overwritten, missing ones are created, and players without stats are reset to zero
+func syncMatchStats(tx *gorm.DB, matchID uint, stats []entities.MatchPlayer) error {
+	var existing []entities.MatchPlayer
+	if err := tx.Where("match_id = ?", matchID).Find(&existing).Error; err != nil {
+		return err
+	}
+
+	byPlayer := make(map[uint]entities.MatchPlayer, len(existing))
+	for _, matchPlayer := range existing {
+		byPlayer[matchPlayer.PlayerID] = matchPlayer
+	}
+
+	for i := range stats {
+		stat := &stats[i]
+		current, ok := byPlayer[stat.PlayerID]
+		if !ok {
+			if err := tx.Create(stat).Error; err != nil {
+				return err
+			}
+			continue
+		}
+
+		delete(byPlayer, stat.PlayerID)
+		stat.ID = current.ID
+		if err := tx.Model(&current).Updates(map[string]interface{}{
+			"team_id":     stat.TeamID,
+			"goals":       stat.Goals,
+			"assists":     stat.Assists,
+			"own_goals":   stat.OwnGoals,
+			"yellow_card": stat.YellowCard,
+			"red_card":    stat.RedCard,
+		}).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, current := range byPlayer {
+		if err := tx.Model(&current).Updates(map[string]interface{}{
+			"goals":       0,
+			"assists":     0,
+			"own_goals":   0,
+			"yellow_card": 0,
+			"red_card":    0,
+		}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Update updates a match player record
 func (r *MatchPlayerRepositoryImpl) Update(matchPlayer *entities.MatchPlayer) error {
 	r.logger.Info("Updating match player with ID: %d", matchPlayer.ID)
@@ -39,10 +39,24 @@ func (r *MatchRepositoryImpl) Create(match *entities.Match) error {
 		First(match, match.ID).Error
 }
 
+// CreateBatch creates several matches inside a single transaction
+func (r *MatchRepositoryImpl) CreateBatch(matches []entities.Match) error {
+	r.logger.Info("Creating %d matches in a single transaction", len(matches))
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		return tx.Create(&matches).Error
+	})
+	if err != nil {
+		r.logger.Error("Failed to create matches in batch: %v", err)
+		return err
+	}
+	r.logger.Info("Successfully created %d matches", len(matches))
+	return nil
+}
+
 // GetByID retrieves a match by ID
 func (r *MatchRepositoryImpl) GetByID(id uint) (*entities.Match, error) {
 	var match entities.Match
-	err := r.db.Preload("HomeTeam").Preload("AwayTeam").First(&match, id).Error
+	err := r.db.Preload("HomeTeam").Preload("AwayTeam").Preload("PenaltyKicks", orderedKicks).First(&match, id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +82,7 @@ func (r *MatchRepositoryImpl) GetWithDetails(id uint) (*entities.Match, error) {
 		Preload("Season").
 		Preload("Stadium").
 		Preload("PlayerStats").
+		Preload("PenaltyKicks", orderedKicks).
 		First(&match, id).Error
 	if err != nil {
 		return nil, err
@@ -131,10 +146,18 @@ func (r *MatchRepositoryImpl) GetCompleted(seasonID uint) ([]entities.Match, err
 	return matches, err
 }
 
+// GetFinished retrieves the finished matches of every season in the order they were played
+func (r *MatchRepositoryImpl) GetFinished() ([]entities.Match, error) {
+	var matches []entities.Match
+	err := r.db.Where("status = ?", entities.MatchStatusFinished).Order("date ASC, id ASC").
+		Find(&matches).Error
+	return matches, err
+}
+
 // GetLive retrieves live matches
 func (r *MatchRepositoryImpl) GetLive() ([]entities.Match, error) {
 	var matches []entities.Match
-	err := r.db.Where("status = ?", entities.MatchStatusInProgress).Find(&matches).Error
+	err := r.db.Where("status IN ?", []entities.MatchStatus{entities.MatchStatusInProgress, entities.MatchStatusHalfTime}).Find(&matches).Error
 	return matches, err
 }
 
@@ -150,6 +173,43 @@ func (r *MatchRepositoryImpl) Update(match *entities.Match) error {
 	return nil
 }
 
+// matchResultColumns are the columns written by SaveResult. They are selected explicitly so
+// that cleared extra time and penalty scores are stored as NULL instead of being skipped.
+var matchResultColumns = []string{
+	"status", "finished_at",
+	"home_team_score", "away_team_score", "home_team_points", "away_team_points",
+	"home_extra_time_score", "away_extra_time_score", "home_penalty_score", "away_penalty_score",
+	"updated_at",
+}
+
+// SaveResult saves the result of a match, replacing its penalty shootout in the same transaction
+func (r *MatchRepositoryImpl) SaveResult(match *entities.Match, replaceKicks bool) error {
+	r.logger.Info("Saving result of match with ID: %d", match.ID)
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if replaceKicks {
+			if err := tx.Where("match_id = ?", match.ID).Delete(&entities.PenaltyKick{}).Error; err != nil {
+				return err
+			}
+			if len(match.PenaltyKicks) > 0 {
+				if err := tx.Create(&match.PenaltyKicks).Error; err != nil {
+					return err
+				}
+			}
+		}
+		return tx.Model(match).Select(matchResultColumns).Updates(match).Error
+	})
+	if err != nil {
+		r.logger.Error("Failed to save result of match with ID %d: %v", match.ID, err)
+		return err
+	}
+	return nil
+}
+
+// orderedKicks preloads penalty kicks in the order they were taken
+func orderedKicks(db *gorm.DB) *gorm.DB {
+	return db.Order("kick_order ASC")
+}
+
 // Delete deletes a match
 func (r *MatchRepositoryImpl) Delete(id uint) error {
 	return r.db.Delete(&entities.Match{}, id).Error
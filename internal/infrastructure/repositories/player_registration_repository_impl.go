@@ -0,0 +1,123 @@
+package repositories
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"catalyst-players/internal/infrastructure/logger"
+
+	"gorm.io/gorm"
+)
+
+// PlayerRegistrationRepositoryImpl implements the PlayerRegistrationRepository interface using GORM
+type PlayerRegistrationRepositoryImpl struct {
+	db     *gorm.DB
+	logger logger.Logger
+}
+
+// NewPlayerRegistrationRepositoryImpl creates a new player registration repository implementation
+func NewPlayerRegistrationRepositoryImpl(db *gorm.DB) repositories.PlayerRegistrationRepository {
+	return &PlayerRegistrationRepositoryImpl{
+		db:     db,
+		logger: logger.NewLogger(),
+	}
+}
+
+// Transfer saves the spell a player leaves, creates the new registration and updates the
+// team and shirt number of the player in a single transaction
+func (r *PlayerRegistrationRepositoryImpl) Transfer(player *entities.Player, previous, registration *entities.PlayerRegistration) error {
+	r.logger.Info("Transferring player %d to team %d for season %d", player.ID, registration.TeamID, registration.SeasonID)
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if previous != nil {
+			if err := tx.Omit("Team", "Season").Save(previous).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Omit("Team", "Season").Create(registration).Error; err != nil {
+			return err
+		}
+		return tx.Model(&entities.Player{}).Where("id = ?", player.ID).
+			Updates(map[string]interface{}{"team_id": player.TeamID, "number": player.Number}).Error
+	})
+	if err != nil {
+		r.logger.Error("Failed to transfer player %d: %v", player.ID, err)
+		return err
+	}
+	return nil
+}
+
+// Register creates a player and their first registration in a single transaction
+func (r *PlayerRegistrationRepositoryImpl) Register(player *entities.Player, registration *entities.PlayerRegistration) error {
+	r.logger.Info("Registering new player with team %d for season %d", registration.TeamID, registration.SeasonID)
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(player).Error; err != nil {
+			return err
+		}
+		registration.PlayerID = player.ID
+		return tx.Omit("Team", "Season").Create(registration).Error
+	})
+	if err != nil {
+		r.logger.Error("Failed to register new player: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetByPlayerID retrieves the registrations of a player with their teams and seasons, oldest first
+func (r *PlayerRegistrationRepositoryImpl) GetByPlayerID(playerID uint) ([]entities.PlayerRegistration, error) {
+	var registrations []entities.PlayerRegistration
+	err := r.db.Preload("Team").Preload("Season").
+		Where("player_id = ?", playerID).
+		Order("`from` ASC, id ASC").
+		Find(&registrations).Error
+	return registrations, err
+}
+
+// GetBySeasonAndTeam retrieves the registrations of a team for a season
+func (r *PlayerRegistrationRepositoryImpl) GetBySeasonAndTeam(seasonID, teamID uint) ([]entities.PlayerRegistration, error) {
+	var registrations []entities.PlayerRegistration
+	err := r.db.Where("season_id = ? AND team_id = ?", seasonID, teamID).
+		Order("`from` ASC, id ASC").
+		Find(&registrations).Error
+	return registrations, err
+}
+
+// TransferWindowRepositoryImpl implements the TransferWindowRepository interface using GORM
+type TransferWindowRepositoryImpl struct {
+	db     *gorm.DB
+	logger logger.Logger
+}
+
+// NewTransferWindowRepositoryImpl creates a new transfer window repository implementation
+func NewTransferWindowRepositoryImpl(db *gorm.DB) repositories.TransferWindowRepository {
+	return &TransferWindowRepositoryImpl{
+		db:     db,
+		logger: logger.NewLogger(),
+	}
+}
+
+// Create creates a new transfer window
+func (r *TransferWindowRepositoryImpl) Create(window *entities.TransferWindow) error {
+	return r.db.Create(window).Error
+}
+
+// GetByID retrieves a transfer window by ID
+func (r *TransferWindowRepositoryImpl) GetByID(id uint) (*entities.TransferWindow, error) {
+	var window entities.TransferWindow
+	err := r.db.First(&window, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &window, nil
+}
+
+// GetBySeasonID retrieves the transfer windows of a season in chronological order
+func (r *TransferWindowRepositoryImpl) GetBySeasonID(seasonID uint) ([]entities.TransferWindow, error) {
+	var windows []entities.TransferWindow
+	err := r.db.Where("season_id = ?", seasonID).Order("opens_at ASC").Find(&windows).Error
+	return windows, err
+}
+
+// Delete deletes a transfer window by ID
+func (r *TransferWindowRepositoryImpl) Delete(id uint) error {
+	return r.db.Delete(&entities.TransferWindow{}, id).Error
+}
@@ -88,12 +88,13 @@ func (r *PlayerRepositoryImpl) Delete(id uint) error {
 	return r.db.Delete(&entities.Player{}, id).Error
 }
 
-// GetTopScorers retrieves top scoring players for a season
+// GetTopScorers retrieves top scoring players for a season, leaving out the goals of
+// matches settled by an administrative result
 func (r *PlayerRepositoryImpl) GetTopScorers(seasonID uint, limit int) ([]entities.Player, error) {
 	var players []entities.Player
 	err := r.db.Joins("JOIN match_player ON match_player.player_id = player.id").
 		Joins("JOIN `match` ON `match`.id = match_player.match_id").
-		Where("`match`.season_id = ?", seasonID).
+		Where("`match`.season_id = ? AND `match`.administrative_result = ''", seasonID).
 		Group("player.id").
 		Order("SUM(match_player.goals) DESC").
 		Limit(limit).
@@ -0,0 +1,111 @@
+package repositories
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"catalyst-players/internal/infrastructure/logger"
+
+	"gorm.io/gorm"
+)
+
+// PromotionRuleRepositoryImpl implements the PromotionRuleRepository interface using GORM
+type PromotionRuleRepositoryImpl struct {
+	db     *gorm.DB
+	logger logger.Logger
+}
+
+// NewPromotionRuleRepositoryImpl creates a new promotion rule repository implementation
+func NewPromotionRuleRepositoryImpl(db *gorm.DB) repositories.PromotionRuleRepository {
+	return &PromotionRuleRepositoryImpl{
+		db:     db,
+		logger: logger.NewLogger(),
+	}
+}
+
+// Save creates a promotion rule or overwrites every column of an existing one,
+// so that zero teams moving and a disabled playoff are stored as well
+func (r *PromotionRuleRepositoryImpl) Save(rule *entities.PromotionRule) error {
+	r.logger.Info("Saving promotion rule between leagues %d and %d", rule.UpperLeagueID, rule.LowerLeagueID)
+	err := r.db.Save(rule).Error
+	if err != nil {
+		r.logger.Error("Failed to save promotion rule: %v", err)
+		return err
+	}
+	return nil
+}
+
+// Delete deletes a promotion rule by ID
+func (r *PromotionRuleRepositoryImpl) Delete(id uint) error {
+	return r.db.Delete(&entities.PromotionRule{}, id).Error
+}
+
+// FindByUpperLeagueID retrieves the rule linking a league to the league below it, or nil if it has none
+func (r *PromotionRuleRepositoryImpl) FindByUpperLeagueID(leagueID uint) (*entities.PromotionRule, error) {
+	return r.findOne("upper_league_id = ?", leagueID)
+}
+
+// FindByLowerLeagueID retrieves the rule linking a league to the league above it, or nil if it has none
+func (r *PromotionRuleRepositoryImpl) FindByLowerLeagueID(leagueID uint) (*entities.PromotionRule, error) {
+	return r.findOne("lower_league_id = ?", leagueID)
+}
+
+// findOne retrieves the first promotion rule matching the condition, or nil if none matches
+func (r *PromotionRuleRepositoryImpl) findOne(query string, args ...interface{}) (*entities.PromotionRule, error) {
+	var rules []entities.PromotionRule
+	err := r.db.Where(query, args...).Limit(1).Find(&rules).Error
+	if err != nil {
+		r.logger.Error("Failed to retrieve promotion rule: %v", err)
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	return &rules[0], nil
+}
+
+// TeamMovementRepositoryImpl implements the TeamMovementRepository interface using GORM
+type TeamMovementRepositoryImpl struct {
+	db     *gorm.DB
+	logger logger.Logger
+}
+
+// NewTeamMovementRepositoryImpl creates a new team movement repository implementation
+func NewTeamMovementRepositoryImpl(db *gorm.DB) repositories.TeamMovementRepository {
+	return &TeamMovementRepositoryImpl{
+		db:     db,
+		logger: logger.NewLogger(),
+	}
+}
+
+// ReplaceForSeason stores the movements of a season in place of any computed before
+func (r *TeamMovementRepositoryImpl) ReplaceForSeason(seasonID uint, movements []entities.TeamMovement) error {
+	r.logger.Info("Storing %d team movements for season %d", len(movements), seasonID)
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("season_id = ?", seasonID).Delete(&entities.TeamMovement{}).Error; err != nil {
+			return err
+		}
+		if len(movements) == 0 {
+			return nil
+		}
+		return tx.Create(&movements).Error
+	})
+	if err != nil {
+		r.logger.Error("Failed to store team movements for season %d: %v", seasonID, err)
+		return err
+	}
+	return nil
+}
+
+// GetBySeasonID retrieves the teams leaving the league of a season
+func (r *TeamMovementRepositoryImpl) GetBySeasonID(seasonID uint) ([]entities.TeamMovement, error) {
+	var movements []entities.TeamMovement
+	err := r.db.Where("season_id = ?", seasonID).Order("direction ASC, position ASC").Find(&movements).Error
+	return movements, err
+}
+
+// GetByToLeagueID retrieves every team movement into a league
+func (r *TeamMovementRepositoryImpl) GetByToLeagueID(leagueID uint) ([]entities.TeamMovement, error) {
+	var movements []entities.TeamMovement
+	err := r.db.Where("to_league_id = ?", leagueID).Order("season_id ASC, position ASC").Find(&movements).Error
+	return movements, err
+}
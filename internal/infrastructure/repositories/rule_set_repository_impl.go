@@ -0,0 +1,65 @@
+package repositories
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"catalyst-players/internal/infrastructure/logger"
+
+	"gorm.io/gorm"
+)
+
+// RuleSetRepositoryImpl implements the RuleSetRepository interface using GORM
+type RuleSetRepositoryImpl struct {
+	db     *gorm.DB
+	logger logger.Logger
+}
+
+// NewRuleSetRepositoryImpl creates a new rule set repository implementation
+func NewRuleSetRepositoryImpl(db *gorm.DB) repositories.RuleSetRepository {
+	return &RuleSetRepositoryImpl{
+		db:     db,
+		logger: logger.NewLogger(),
+	}
+}
+
+// Save creates a rule set or overwrites every column of an existing one,
+// so that zero points for a draw or a loss are stored as well
+func (r *RuleSetRepositoryImpl) Save(ruleSet *entities.RuleSet) error {
+	r.logger.Info("Saving rule set with ID: %d", ruleSet.ID)
+	err := r.db.Save(ruleSet).Error
+	if err != nil {
+		r.logger.Error("Failed to save rule set: %v", err)
+		return err
+	}
+	r.logger.Info("Successfully saved rule set with ID: %d", ruleSet.ID)
+	return nil
+}
+
+// Delete deletes a rule set by ID
+func (r *RuleSetRepositoryImpl) Delete(id uint) error {
+	return r.db.Delete(&entities.RuleSet{}, id).Error
+}
+
+// FindByLeagueID retrieves the rule set of a league, or nil if it has none
+func (r *RuleSetRepositoryImpl) FindByLeagueID(leagueID uint) (*entities.RuleSet, error) {
+	return r.findOne("league_id = ?", leagueID)
+}
+
+// FindBySeasonID retrieves the rule set of a season, or nil if it has none
+func (r *RuleSetRepositoryImpl) FindBySeasonID(seasonID uint) (*entities.RuleSet, error) {
+	return r.findOne("season_id = ?", seasonID)
+}
+
+// findOne retrieves the first rule set matching the condition, or nil if none matches
+func (r *RuleSetRepositoryImpl) findOne(query string, args ...interface{}) (*entities.RuleSet, error) {
+	var ruleSets []entities.RuleSet
+	err := r.db.Where(query, args...).Limit(1).Find(&ruleSets).Error
+	if err != nil {
+		r.logger.Error("Failed to retrieve rule set: %v", err)
+		return nil, err
+	}
+	if len(ruleSets) == 0 {
+		return nil, nil
+	}
+	return &ruleSets[0], nil
+}
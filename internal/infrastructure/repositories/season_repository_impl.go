@@ -161,3 +161,79 @@ func (r *SeasonRepositoryImpl) Delete(id uint) error {
 	r.logger.Info("Successfully deleted season with ID: %d", id)
 	return nil
 }
+
+// AddTeam enrolls a team in a season through season_team
+func (r *SeasonRepositoryImpl) AddTeam(seasonID uint, teamID uint) error {
+	r.logger.Info("Adding team %d to season %d", teamID, seasonID)
+	err := r.db.Omit("Teams.*").Model(&entities.Season{ID: seasonID}).Association("Teams").Append(&entities.Team{ID: teamID})
+	if err != nil {
+		r.logger.Error("Failed to add team %d to season %d: %v", teamID, seasonID, err)
+		return err
+	}
+	return nil
+}
+
+// RemoveTeam withdraws a team from a season, leaving the team itself untouched
+func (r *SeasonRepositoryImpl) RemoveTeam(seasonID uint, teamID uint) error {
+	r.logger.Info("Removing team %d from season %d", teamID, seasonID)
+	err := r.db.Model(&entities.Season{ID: seasonID}).Association("Teams").Delete(&entities.Team{ID: teamID})
+	if err != nil {
+		r.logger.Error("Failed to remove team %d from season %d: %v", teamID, seasonID, err)
+		return err
+	}
+	return nil
+}
+
+// ReplaceTeams sets the teams enrolled in a season in a single transaction
+func (r *SeasonRepositoryImpl) ReplaceTeams(seasonID uint, teamIDs []uint) error {
+	r.logger.Info("Replacing the teams of season %d with %d teams", seasonID, len(teamIDs))
+	teams := make([]entities.Team, 0, len(teamIDs))
+	for _, teamID := range teamIDs {
+		teams = append(teams, entities.Team{ID: teamID})
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		season := &entities.Season{ID: seasonID}
+		if len(teams) == 0 {
+			return tx.Model(season).Association("Teams").Clear()
+		}
+		return tx.Omit("Teams.*").Model(season).Association("Teams").Replace(teams)
+	})
+	if err != nil {
+		r.logger.Error("Failed to replace the teams of season %d: %v", seasonID, err)
+		return err
+	}
+	return nil
+}
+
+// CreateWithSetup creates a season with its enrolled teams, its own rule set and its matches
+// in a single transaction. The rule set and the matches are optional.
+func (r *SeasonRepositoryImpl) CreateWithSetup(season *entities.Season, ruleSet *entities.RuleSet, matches []entities.Match) error {
+	r.logger.Info("Creating season %q with %d teams and %d matches", season.Name, len(season.Teams), len(matches))
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Teams.*", "League", "Matches").Create(season).Error; err != nil {
+			return err
+		}
+
+		if ruleSet != nil {
+			ruleSet.SeasonID = &season.ID
+			if err := tx.Create(ruleSet).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(matches) == 0 {
+			return nil
+		}
+		for i := range matches {
+			matches[i].SeasonID = season.ID
+		}
+		return tx.Create(&matches).Error
+	})
+	if err != nil {
+		r.logger.Error("Failed to create season %q: %v", season.Name, err)
+		return err
+	}
+	r.logger.Info("Successfully created season with ID: %d", season.ID)
+	return nil
+}
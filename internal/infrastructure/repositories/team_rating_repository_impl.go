@@ -0,0 +1,80 @@
+package repositories
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"catalyst-players/internal/infrastructure/logger"
+
+	"gorm.io/gorm"
+)
+
+// teamRatingBatchSize is the number of ratings inserted per statement when replacing them all
+const teamRatingBatchSize = 500
+
+// TeamRatingRepositoryImpl implements the TeamRatingRepository interface using GORM
+type TeamRatingRepositoryImpl struct {
+	db     *gorm.DB
+	logger logger.Logger
+}
+
+// NewTeamRatingRepositoryImpl creates a new team rating repository implementation
+func NewTeamRatingRepositoryImpl(db *gorm.DB) repositories.TeamRatingRepository {
+	return &TeamRatingRepositoryImpl{
+		db:     db,
+		logger: logger.NewLogger(),
+	}
+}
+
+// Create appends the ratings of newly finished matches
+func (r *TeamRatingRepositoryImpl) Create(ratings []entities.TeamRating) error {
+	if len(ratings) == 0 {
+		return nil
+	}
+	err := r.db.Create(&ratings).Error
+	if err != nil {
+		r.logger.Error("Failed to create team ratings: %v", err)
+		return err
+	}
+	return nil
+}
+
+// ReplaceAll stores a full replay of the ratings in place of every rating stored before
+func (r *TeamRatingRepositoryImpl) ReplaceAll(ratings []entities.TeamRating) error {
+	r.logger.Info("Replacing team ratings with %d recomputed ratings", len(ratings))
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(&entities.TeamRating{}).Error; err != nil {
+			return err
+		}
+		if len(ratings) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(&ratings, teamRatingBatchSize).Error
+	})
+	if err != nil {
+		r.logger.Error("Failed to replace team ratings: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetByTeamID retrieves the rating history of a team in the order the matches were played
+func (r *TeamRatingRepositoryImpl) GetByTeamID(teamID uint) ([]entities.TeamRating, error) {
+	var ratings []entities.TeamRating
+	err := r.db.Where("team_id = ?", teamID).Order("id ASC").Find(&ratings).Error
+	return ratings, err
+}
+
+// GetByMatchID retrieves the ratings computed from a match
+func (r *TeamRatingRepositoryImpl) GetByMatchID(matchID uint) ([]entities.TeamRating, error) {
+	var ratings []entities.TeamRating
+	err := r.db.Where("match_id = ?", matchID).Find(&ratings).Error
+	return ratings, err
+}
+
+// GetLatest retrieves the current rating of every rated team
+func (r *TeamRatingRepositoryImpl) GetLatest() ([]entities.TeamRating, error) {
+	var ratings []entities.TeamRating
+	latest := r.db.Model(&entities.TeamRating{}).Select("MAX(id)").Group("team_id")
+	err := r.db.Where("id IN (?)", latest).Find(&ratings).Error
+	return ratings, err
+}
@@ -0,0 +1,67 @@
+package repositories
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"catalyst-players/internal/infrastructure/logger"
+
+	"gorm.io/gorm"
+)
+
+// TeamSanctionRepositoryImpl implements the TeamSanctionRepository interface using GORM
+type TeamSanctionRepositoryImpl struct {
+	db     *gorm.DB
+	logger logger.Logger
+}
+
+// NewTeamSanctionRepositoryImpl creates a new team sanction repository implementation
+func NewTeamSanctionRepositoryImpl(db *gorm.DB) repositories.TeamSanctionRepository {
+	return &TeamSanctionRepositoryImpl{
+		db:     db,
+		logger: logger.NewLogger(),
+	}
+}
+
+// Create creates a new team sanction
+func (r *TeamSanctionRepositoryImpl) Create(sanction *entities.TeamSanction) error {
+	r.logger.Info("Deducting %d points from team %d in season %d", sanction.PointsDeducted, sanction.TeamID, sanction.SeasonID)
+	err := r.db.Create(sanction).Error
+	if err != nil {
+		r.logger.Error("Failed to create team sanction: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetByID retrieves a team sanction by ID
+func (r *TeamSanctionRepositoryImpl) GetByID(id uint) (*entities.TeamSanction, error) {
+	var sanction entities.TeamSanction
+	err := r.db.First(&sanction, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &sanction, nil
+}
+
+// GetBySeasonID retrieves the sanctions of a season in date order
+func (r *TeamSanctionRepositoryImpl) GetBySeasonID(seasonID uint) ([]entities.TeamSanction, error) {
+	var sanctions []entities.TeamSanction
+	err := r.db.Where("season_id = ?", seasonID).Order("date ASC, id ASC").Find(&sanctions).Error
+	return sanctions, err
+}
+
+// Save overwrites every column of an existing team sanction, so that a removed match
+// reference is stored as well
+func (r *TeamSanctionRepositoryImpl) Save(sanction *entities.TeamSanction) error {
+	err := r.db.Save(sanction).Error
+	if err != nil {
+		r.logger.Error("Failed to save team sanction: %v", err)
+		return err
+	}
+	return nil
+}
+
+// Delete deletes a team sanction by ID
+func (r *TeamSanctionRepositoryImpl) Delete(id uint) error {
+	return r.db.Delete(&entities.TeamSanction{}, id).Error
+}
@@ -0,0 +1,78 @@
+package repositories
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"catalyst-players/internal/infrastructure/logger"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TieRepositoryImpl implements the TieRepository interface using GORM
+type TieRepositoryImpl struct {
+	db     *gorm.DB
+	logger logger.Logger
+}
+
+// NewTieRepositoryImpl creates a new tie repository implementation
+func NewTieRepositoryImpl(db *gorm.DB) repositories.TieRepository {
+	return &TieRepositoryImpl{
+		db:     db,
+		logger: logger.NewLogger(),
+	}
+}
+
+// Create creates a new tie between two existing legs
+func (r *TieRepositoryImpl) Create(tie *entities.Tie) error {
+	r.logger.Info("Creating tie between matches %d and %d", tie.FirstLegID, tie.SecondLegID)
+	err := r.db.Omit(clause.Associations).Create(tie).Error
+	if err != nil {
+		r.logger.Error("Failed to create tie: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetByID retrieves a tie by ID with both legs
+func (r *TieRepositoryImpl) GetByID(id uint) (*entities.Tie, error) {
+	var tie entities.Tie
+	err := r.withLegs().First(&tie, id).Error
+	if err != nil {
+		r.logger.Error("Failed to retrieve tie with ID %d: %v", id, err)
+		return nil, err
+	}
+	return &tie, nil
+}
+
+// GetBySeasonID retrieves the ties of a season with both legs
+func (r *TieRepositoryImpl) GetBySeasonID(seasonID uint) ([]entities.Tie, error) {
+	var ties []entities.Tie
+	err := r.withLegs().Where("season_id = ?", seasonID).Order("stage ASC, bracket_slot ASC").Find(&ties).Error
+	return ties, err
+}
+
+// FindByMatchID retrieves the tie a match is a leg of, or nil if there is none
+func (r *TieRepositoryImpl) FindByMatchID(matchID uint) (*entities.Tie, error) {
+	var ties []entities.Tie
+	err := r.withLegs().Where("first_leg_id = ? OR second_leg_id = ?", matchID, matchID).Limit(1).Find(&ties).Error
+	if err != nil {
+		r.logger.Error("Failed to retrieve tie of match %d: %v", matchID, err)
+		return nil, err
+	}
+	if len(ties) == 0 {
+		return nil, nil
+	}
+	return &ties[0], nil
+}
+
+// Delete deletes a tie, leaving its legs untouched
+func (r *TieRepositoryImpl) Delete(id uint) error {
+	return r.db.Delete(&entities.Tie{}, id).Error
+}
+
+// withLegs preloads both legs of a tie with their teams
+func (r *TieRepositoryImpl) withLegs() *gorm.DB {
+	return r.db.Preload("FirstLeg.HomeTeam").Preload("FirstLeg.AwayTeam").
+		Preload("SecondLeg.HomeTeam").Preload("SecondLeg.AwayTeam")
+}
@@ -0,0 +1,131 @@
+package repositories
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/domain/repositories"
+	"catalyst-players/internal/infrastructure/logger"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// WebhookSubscriptionRepositoryImpl implements the WebhookSubscriptionRepository interface using GORM
+type WebhookSubscriptionRepositoryImpl struct {
+	db     *gorm.DB
+	logger logger.Logger
+}
+
+// NewWebhookSubscriptionRepositoryImpl creates a new webhook subscription repository implementation
+func NewWebhookSubscriptionRepositoryImpl(db *gorm.DB) repositories.WebhookSubscriptionRepository {
+	return &WebhookSubscriptionRepositoryImpl{
+		db:     db,
+		logger: logger.NewLogger(),
+	}
+}
+
+// Create creates a new webhook subscription
+func (r *WebhookSubscriptionRepositoryImpl) Create(subscription *entities.WebhookSubscription) error {
+	return r.db.Create(subscription).Error
+}
+
+// GetByID retrieves a webhook subscription by ID
+func (r *WebhookSubscriptionRepositoryImpl) GetByID(id uint) (*entities.WebhookSubscription, error) {
+	var subscription entities.WebhookSubscription
+	err := r.db.First(&subscription, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+// GetAll retrieves all webhook subscriptions
+func (r *WebhookSubscriptionRepositoryImpl) GetAll() ([]entities.WebhookSubscription, error) {
+	var subscriptions []entities.WebhookSubscription
+	err := r.db.Find(&subscriptions).Error
+	return subscriptions, err
+}
+
+// GetActive retrieves the webhook subscriptions that are not disabled
+func (r *WebhookSubscriptionRepositoryImpl) GetActive() ([]entities.WebhookSubscription, error) {
+	var subscriptions []entities.WebhookSubscription
+	err := r.db.Where("disabled = ?", false).Find(&subscriptions).Error
+	return subscriptions, err
+}
+
+// Save overwrites every column of a webhook subscription, so it can be enabled again
+func (r *WebhookSubscriptionRepositoryImpl) Save(subscription *entities.WebhookSubscription) error {
+	r.logger.Info("Saving webhook subscription with ID: %d", subscription.ID)
+	err := r.db.Save(subscription).Error
+	if err != nil {
+		r.logger.Error("Failed to save webhook subscription with ID %d: %v", subscription.ID, err)
+		return err
+	}
+	return nil
+}
+
+// Delete deletes a webhook subscription and its delivery log
+func (r *WebhookSubscriptionRepositoryImpl) Delete(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("subscription_id = ?", id).Delete(&entities.WebhookDelivery{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&entities.WebhookSubscription{}, id).Error
+	})
+}
+
+// WebhookDeliveryRepositoryImpl implements the WebhookDeliveryRepository interface using GORM
+type WebhookDeliveryRepositoryImpl struct {
+	db     *gorm.DB
+	logger logger.Logger
+}
+
+// NewWebhookDeliveryRepositoryImpl creates a new webhook delivery repository implementation
+func NewWebhookDeliveryRepositoryImpl(db *gorm.DB) repositories.WebhookDeliveryRepository {
+	return &WebhookDeliveryRepositoryImpl{
+		db:     db,
+		logger: logger.NewLogger(),
+	}
+}
+
+// Create adds a delivery to the queue
+func (r *WebhookDeliveryRepositoryImpl) Create(delivery *entities.WebhookDelivery) error {
+	return r.db.Create(delivery).Error
+}
+
+// Save overwrites every column of a delivery after an attempt
+func (r *WebhookDeliveryRepositoryImpl) Save(delivery *entities.WebhookDelivery) error {
+	err := r.db.Save(delivery).Error
+	if err != nil {
+		r.logger.Error("Failed to save webhook delivery with ID %d: %v", delivery.ID, err)
+		return err
+	}
+	return nil
+}
+
+// GetDue retrieves the oldest pending deliveries whose next attempt is due
+func (r *WebhookDeliveryRepositoryImpl) GetDue(now time.Time, limit int) ([]entities.WebhookDelivery, error) {
+	var deliveries []entities.WebhookDelivery
+	err := r.db.Where("status = ? AND next_attempt_at <= ?", entities.WebhookDeliveryPending, now).
+		Order("next_attempt_at ASC, id ASC").
+		Limit(limit).
+		Find(&deliveries).Error
+	return deliveries, err
+}
+
+// GetBySubscriptionID retrieves the delivery log of a subscription, newest first
+func (r *WebhookDeliveryRepositoryImpl) GetBySubscriptionID(subscriptionID uint) ([]entities.WebhookDelivery, error) {
+	var deliveries []entities.WebhookDelivery
+	err := r.db.Where("subscription_id = ?", subscriptionID).
+		Order("created_at DESC, id DESC").
+		Find(&deliveries).Error
+	return deliveries, err
+}
+
+// GetByEvent retrieves the deliveries queued for one occurrence of an event
+func (r *WebhookDeliveryRepositoryImpl) GetByEvent(eventType entities.WebhookEventType, eventKey string) ([]entities.WebhookDelivery, error) {
+	var deliveries []entities.WebhookDelivery
+	err := r.db.Where("event_type = ? AND event_key = ?", eventType, eventKey).
+		Order("id").
+		Find(&deliveries).Error
+	return deliveries, err
+}
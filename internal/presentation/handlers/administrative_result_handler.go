@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"catalyst-players/internal/application/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdministrativeResultHandler handles HTTP requests for forfeits, walkovers and awarded results
+type AdministrativeResultHandler struct {
+	administrativeResultService *services.AdministrativeResultService
+}
+
+// NewAdministrativeResultHandler creates a new administrative result handler
+func NewAdministrativeResultHandler(administrativeResultService *services.AdministrativeResultService) *AdministrativeResultHandler {
+	return &AdministrativeResultHandler{
+		administrativeResultService: administrativeResultService,
+	}
+}
+
+// ApplyResult handles POST /matches/:id/administrative-result
+func (h *AdministrativeResultHandler) ApplyResult(c *gin.Context) {
+	matchID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid match ID"})
+		return
+	}
+
+	var request services.AdministrativeResultRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	match, err := h.administrativeResultService.ApplyResult(uint(matchID), request)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, match)
+}
+
+// GetDecisions handles GET /matches/:id/administrative-decisions
+func (h *AdministrativeResultHandler) GetDecisions(c *gin.Context) {
+	matchID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid match ID"})
+		return
+	}
+
+	decisions, err := h.administrativeResultService.GetDecisions(uint(matchID))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, decisions)
+}
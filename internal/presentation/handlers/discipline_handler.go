@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"catalyst-players/internal/application/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DisciplineHandler handles HTTP requests for player suspensions
+type DisciplineHandler struct {
+	disciplineService *services.DisciplineService
+}
+
+// NewDisciplineHandler creates a new discipline handler
+func NewDisciplineHandler(disciplineService *services.DisciplineService) *DisciplineHandler {
+	return &DisciplineHandler{
+		disciplineService: disciplineService,
+	}
+}
+
+// GetSeasonSuspensions handles GET /seasons/:id/suspensions
+func (h *DisciplineHandler) GetSeasonSuspensions(c *gin.Context) {
+	seasonID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid season ID"})
+		return
+	}
+
+	suspensions, err := h.disciplineService.GetSeasonSuspensions(uint(seasonID))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, suspensions)
+}
+
+// GetPlayerSuspensions handles GET /players/:id/suspensions
+func (h *DisciplineHandler) GetPlayerSuspensions(c *gin.Context) {
+	playerID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid player ID"})
+		return
+	}
+
+	suspensions, err := h.disciplineService.GetPlayerSuspensions(uint(playerID))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, suspensions)
+}
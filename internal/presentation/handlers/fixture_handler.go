@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"catalyst-players/internal/application/services"
+	"catalyst-players/internal/infrastructure/logger"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FixtureHandler handles HTTP requests for fixture generation
+type FixtureHandler struct {
+	logger         logger.Logger
+	fixtureService *services.FixtureService
+}
+
+// NewFixtureHandler creates a new fixture handler
+func NewFixtureHandler(fixtureService *services.FixtureService) *FixtureHandler {
+	return &FixtureHandler{
+		logger:         logger.NewLogger(),
+		fixtureService: fixtureService,
+	}
+}
+
+// GenerateFixtures handles POST /seasons/:id/fixtures/generate
+func (h *FixtureHandler) GenerateFixtures(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid season ID"})
+		return
+	}
+
+	var opts services.FixtureOptions
+	if err := c.ShouldBindJSON(&opts); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.logger.Info("Generating fixtures for season ID: %d (dry run: %t)", uint(id), opts.DryRun)
+	matches, err := h.fixtureService.GenerateFixtures(uint(id), opts)
+	if err != nil {
+		h.logger.Error("Failed to generate fixtures for season ID %d: %v", uint(id), err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	status := http.StatusCreated
+	if opts.DryRun {
+		status = http.StatusOK
+	}
+
+	h.logger.Info("Generated %d matches for season ID: %d", len(matches), uint(id))
+	c.JSON(status, gin.H{"dry_run": opts.DryRun, "matches": matches})
+}
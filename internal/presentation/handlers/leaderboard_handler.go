@@ -2,8 +2,10 @@ package handlers
 
 import (
 	"catalyst-players/internal/application/services"
+	"catalyst-players/internal/domain/entities"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -25,6 +27,9 @@ func NewLeaderboardHandler(leaderboardService *services.LeaderboardService) *Lea
 // @Accept json
 // @Produce json
 // @Param seasonId path int true "Season ID"
+// @Param as_of query string false "Date (YYYY-MM-DD) or time (RFC 3339) to compute the table at"
+// @Param venue query string false "Only count home or away matches (home, away)"
+// @Param form query int false "Number of latest results in the form guide (default 5)"
 // @Success 200 {object} entities.Leaderboard
 // @Failure 400 {object} map[string]string "Invalid season ID"
 // @Failure 500 {object} map[string]string "Internal server error"
@@ -37,7 +42,29 @@ func (h *LeaderboardHandler) GetLeaderboard(c *gin.Context) {
 		return
 	}
 
-	leaderboard, err := h.leaderboardService.GenerateLeaderboard(uint(seasonID))
+	opts := services.LeaderboardOptions{Venue: entities.Venue(c.Query("venue"))}
+	if opts.Venue != entities.VenueAll && opts.Venue != entities.VenueHome && opts.Venue != entities.VenueAway {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid venue. Use home or away"})
+		return
+	}
+
+	if asOfStr := c.Query("as_of"); asOfStr != "" {
+		opts.AsOf, err = parseAsOf(asOfStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid as_of format. Use YYYY-MM-DD or RFC 3339"})
+			return
+		}
+	}
+
+	if formStr := c.Query("form"); formStr != "" {
+		opts.FormLength, err = strconv.Atoi(formStr)
+		if err != nil || opts.FormLength < 1 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid form length"})
+			return
+		}
+	}
+
+	leaderboard, err := h.leaderboardService.GenerateLeaderboardWithOptions(uint(seasonID), opts)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate leaderboard"})
 		return
@@ -45,3 +72,44 @@ func (h *LeaderboardHandler) GetLeaderboard(c *gin.Context) {
 
 	c.JSON(http.StatusOK, leaderboard)
 }
+
+// GetHistory retrieves the standings of a season after every round.
+// @Summary Get season standings history
+// @Description Get the leaderboard after each round of a season, or after each match day when matches have no round.
+// @Tags Leaderboards
+// @Accept json
+// @Produce json
+// @Param seasonId path int true "Season ID"
+// @Success 200 {object} entities.StandingsHistory
+// @Failure 400 {object} map[string]string "Invalid season ID"
+// @Failure 500 {object} map[string]string "Internal server error"
+// @Router /leaderboards/season/{seasonId}/history [get]
+func (h *LeaderboardHandler) GetHistory(c *gin.Context) {
+	seasonID, err := strconv.ParseUint(c.Param("seasonId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid season ID"})
+		return
+	}
+
+	history, err := h.leaderboardService.GenerateHistory(uint(seasonID))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate standings history"})
+		return
+	}
+
+	c.JSON(http.StatusOK, history)
+}
+
+// parseAsOf parses a point in time given as an RFC 3339 time or as a date, which stands
+// for the end of that day
+func parseAsOf(value string) (time.Time, error) {
+	if asOf, err := time.Parse(time.RFC3339, value); err == nil {
+		return asOf, nil
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return date.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+}
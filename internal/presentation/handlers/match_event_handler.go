@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"catalyst-players/internal/application/services"
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MatchEventHandler handles HTTP requests for match timeline events
+type MatchEventHandler struct {
+	matchEventService *services.MatchEventService
+}
+
+// NewMatchEventHandler creates a new match event handler
+func NewMatchEventHandler(matchEventService *services.MatchEventService) *MatchEventHandler {
+	return &MatchEventHandler{
+		matchEventService: matchEventService,
+	}
+}
+
+// CreateEvent handles POST /matches/:id/events
+func (h *MatchEventHandler) CreateEvent(c *gin.Context) {
+	matchID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid match ID"})
+		return
+	}
+
+	var event entities.MatchEvent
+	if err := c.ShouldBindJSON(&event); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.matchEventService.CreateEvent(uint(matchID), &event); err != nil {
+		var eligibility *services.EligibilityError
+		if errors.As(err, &eligibility) {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error(), "violations": eligibility.Violations})
+			return
+		}
+		if errors.Is(err, services.ErrAdministrativeResult) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, event)
+}
+
+// GetEvents handles GET /matches/:id/events
+func (h *MatchEventHandler) GetEvents(c *gin.Context) {
+	matchID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid match ID"})
+		return
+	}
+
+	events, err := h.matchEventService.GetEventsByMatchID(uint(matchID))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, events)
+}
+
+// UpdateEvent handles PUT /matches/:id/events/:eventId
+func (h *MatchEventHandler) UpdateEvent(c *gin.Context) {
+	matchID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid match ID"})
+		return
+	}
+
+	eventID, err := strconv.ParseUint(c.Param("eventId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid match event ID"})
+		return
+	}
+
+	var event entities.MatchEvent
+	if err := c.ShouldBindJSON(&event); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	event.ID = uint(eventID)
+	if err := h.matchEventService.UpdateEvent(uint(matchID), &event); err != nil {
+		var eligibility *services.EligibilityError
+		if errors.As(err, &eligibility) {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error(), "violations": eligibility.Violations})
+			return
+		}
+		if errors.Is(err, services.ErrAdministrativeResult) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, event)
+}
+
+// DeleteEvent handles DELETE /matches/:id/events/:eventId
+func (h *MatchEventHandler) DeleteEvent(c *gin.Context) {
+	matchID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid match ID"})
+		return
+	}
+
+	eventID, err := strconv.ParseUint(c.Param("eventId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid match event ID"})
+		return
+	}
+
+	if err := h.matchEventService.DeleteEvent(uint(matchID), uint(eventID)); err != nil {
+		if errors.Is(err, services.ErrAdministrativeResult) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Match event deleted successfully"})
+}
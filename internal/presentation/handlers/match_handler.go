@@ -3,6 +3,7 @@ package handlers
 import (
 	"catalyst-players/internal/application/services"
 	"catalyst-players/internal/domain/entities"
+	"errors"
 	"net/http"
 	"strconv"
 	"time"
@@ -224,6 +225,10 @@ func (h *MatchHandler) UpdateMatch(c *gin.Context) {
 
 	match.ID = uint(id)
 	if err := h.matchService.UpdateMatch(&match); err != nil {
+		if errors.Is(err, services.ErrInvalidMatchTransition) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -239,9 +244,13 @@ func (h *MatchHandler) UpdateMatchScore(c *gin.Context) {
 		return
 	}
 
+	// Pointers let a goalless draw pass the required check before a penalty shootout
 	var request struct {
-		HomeScore int `json:"home_score" binding:"required"`
-		AwayScore int `json:"away_score" binding:"required"`
+		HomeScore          *int                   `json:"home_score" binding:"required"`
+		AwayScore          *int                   `json:"away_score" binding:"required"`
+		HomeExtraTimeScore *int                   `json:"home_extra_time_score"`
+		AwayExtraTimeScore *int                   `json:"away_extra_time_score"`
+		PenaltyKicks       []entities.PenaltyKick `json:"penalty_kicks"`
 	}
 
 	if err := c.ShouldBindJSON(&request); err != nil {
@@ -249,7 +258,17 @@ func (h *MatchHandler) UpdateMatchScore(c *gin.Context) {
 		return
 	}
 
-	if err := h.matchService.UpdateMatchScore(uint(id), request.HomeScore, request.AwayScore); err != nil {
+	if err := h.matchService.UpdateMatchResult(uint(id), services.MatchResult{
+		HomeScore:          *request.HomeScore,
+		AwayScore:          *request.AwayScore,
+		HomeExtraTimeScore: request.HomeExtraTimeScore,
+		AwayExtraTimeScore: request.AwayExtraTimeScore,
+		PenaltyKicks:       request.PenaltyKicks,
+	}); err != nil {
+		if errors.Is(err, services.ErrAdministrativeResult) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"catalyst-players/internal/application/services"
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MatchLifecycleHandler handles HTTP requests that move a match through its statuses
+type MatchLifecycleHandler struct {
+	lifecycleService *services.MatchLifecycleService
+}
+
+// NewMatchLifecycleHandler creates a new match lifecycle handler
+func NewMatchLifecycleHandler(lifecycleService *services.MatchLifecycleService) *MatchLifecycleHandler {
+	return &MatchLifecycleHandler{
+		lifecycleService: lifecycleService,
+	}
+}
+
+// Start handles POST /matches/:id/start
+func (h *MatchLifecycleHandler) Start(c *gin.Context) {
+	h.transition(c, h.lifecycleService.Start)
+}
+
+// HalfTime handles POST /matches/:id/halftime
+func (h *MatchLifecycleHandler) HalfTime(c *gin.Context) {
+	h.transition(c, h.lifecycleService.HalfTime)
+}
+
+// Finish handles POST /matches/:id/finish
+func (h *MatchLifecycleHandler) Finish(c *gin.Context) {
+	h.transition(c, h.lifecycleService.Finish)
+}
+
+// Postpone handles POST /matches/:id/postpone
+func (h *MatchLifecycleHandler) Postpone(c *gin.Context) {
+	h.transition(c, h.lifecycleService.Postpone)
+}
+
+// Cancel handles POST /matches/:id/cancel
+func (h *MatchLifecycleHandler) Cancel(c *gin.Context) {
+	h.transition(c, h.lifecycleService.Cancel)
+}
+
+// Abandon handles POST /matches/:id/abandon
+func (h *MatchLifecycleHandler) Abandon(c *gin.Context) {
+	h.transition(c, h.lifecycleService.Abandon)
+}
+
+// Reschedule handles POST /matches/:id/reschedule with an optional new date
+func (h *MatchLifecycleHandler) Reschedule(c *gin.Context) {
+	var request struct {
+		Date time.Time `json:"date"`
+	}
+
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	h.transition(c, func(matchID uint) (*entities.Match, error) {
+		return h.lifecycleService.Reschedule(matchID, request.Date)
+	})
+}
+
+// transition runs a status change on the match of the request and writes the updated match
+func (h *MatchLifecycleHandler) transition(c *gin.Context, change func(matchID uint) (*entities.Match, error)) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid match ID"})
+		return
+	}
+
+	match, err := change(uint(id))
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidMatchTransition) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, match)
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"catalyst-players/internal/application/services"
 	"catalyst-players/internal/domain/entities"
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -30,6 +31,11 @@ func (h *MatchPlayerHandler) CreateMatchPlayer(c *gin.Context) {
 	}
 
 	if err := h.matchPlayerService.CreateMatchPlayer(&matchPlayer); err != nil {
+		var eligibility *services.EligibilityError
+		if errors.As(err, &eligibility) {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error(), "violations": eligibility.Violations})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -155,6 +161,11 @@ func (h *MatchPlayerHandler) UpdateMatchPlayer(c *gin.Context) {
 
 	matchPlayer.ID = uint(id)
 	if err := h.matchPlayerService.UpdateMatchPlayer(&matchPlayer); err != nil {
+		var eligibility *services.EligibilityError
+		if errors.As(err, &eligibility) {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error(), "violations": eligibility.Violations})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
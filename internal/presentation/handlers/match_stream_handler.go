@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"catalyst-players/internal/application/services"
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/infrastructure/broker"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// streamKeepAliveInterval is how often an idle stream sends a comment to keep proxies from closing it
+const streamKeepAliveInterval = 15 * time.Second
+
+// MatchStreamHandler streams live match updates to clients using Server-Sent Events
+type MatchStreamHandler struct {
+	matchService *services.MatchService
+	broker       *broker.Broker
+}
+
+// NewMatchStreamHandler creates a new match stream handler
+func NewMatchStreamHandler(matchService *services.MatchService, broker *broker.Broker) *MatchStreamHandler {
+	return &MatchStreamHandler{
+		matchService: matchService,
+		broker:       broker,
+	}
+}
+
+// StreamLive handles GET /matches/live/stream. It sends the live matches first
+// and then every score, status and player statistics update.
+func (h *MatchStreamHandler) StreamLive(c *gin.Context) {
+	live, err := h.matchService.GetLive()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.stream(c, "snapshot", live, nil)
+}
+
+// StreamMatch handles GET /matches/:id/stream. It sends the match first and then its updates.
+func (h *MatchStreamHandler) StreamMatch(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid match ID"})
+		return
+	}
+
+	match, err := h.matchService.GetMatchByID(uint(id))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Match not found"})
+		return
+	}
+
+	h.stream(c, "snapshot", match, func(update entities.MatchUpdate) bool {
+		return update.MatchID == match.ID
+	})
+}
+
+// stream writes the snapshot event and then forwards broker updates until the client
+// disconnects or the subscriber is evicted for not keeping up
+func (h *MatchStreamHandler) stream(c *gin.Context, snapshotEvent string, snapshot interface{}, filter broker.Filter) {
+	sub := h.broker.Subscribe(filter)
+	defer h.broker.Unsubscribe(sub)
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+
+	c.SSEvent(snapshotEvent, snapshot)
+	c.Writer.Flush()
+
+	keepAlive := time.NewTicker(streamKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case update, ok := <-sub.Updates():
+			if !ok {
+				c.SSEvent("evicted", gin.H{"error": "stream closed because the client fell too far behind"})
+				return false
+			}
+			c.SSEvent(string(update.Type), update)
+			return true
+		case <-keepAlive.C:
+			_, err := io.WriteString(w, ": keep-alive\n\n")
+			return err == nil
+		case <-c.Request.Context().Done():
+			return false
+		}
+	})
+}
@@ -3,8 +3,10 @@ package handlers
 import (
 	"catalyst-players/internal/application/services"
 	"catalyst-players/internal/domain/entities"
+	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -23,13 +25,22 @@ func NewPlayerHandler(playerService *services.PlayerService) *PlayerHandler {
 
 // CreatePlayer handles POST /players
 func (h *PlayerHandler) CreatePlayer(c *gin.Context) {
-	var player entities.Player
-	if err := c.ShouldBindJSON(&player); err != nil {
+	var request struct {
+		entities.Player
+		SeasonID uint      `json:"season_id" binding:"required"`
+		Date     time.Time `json:"date"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	if err := h.playerService.CreatePlayer(&player); err != nil {
+	player := request.Player
+	if err := h.playerService.CreatePlayer(&player, request.SeasonID, request.Date); err != nil {
+		if errors.Is(err, services.ErrTransferWindowClosed) {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -115,6 +126,10 @@ func (h *PlayerHandler) UpdatePlayer(c *gin.Context) {
 
 	player.ID = uint(id)
 	if err := h.playerService.UpdatePlayer(&player); err != nil {
+		if errors.Is(err, services.ErrPlayerTeamChange) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
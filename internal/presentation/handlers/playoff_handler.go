@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"catalyst-players/internal/application/services"
+	"catalyst-players/internal/infrastructure/logger"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PlayoffHandler handles HTTP requests for knockout brackets
+type PlayoffHandler struct {
+	logger         logger.Logger
+	playoffService *services.PlayoffService
+}
+
+// NewPlayoffHandler creates a new playoff handler
+func NewPlayoffHandler(playoffService *services.PlayoffService) *PlayoffHandler {
+	return &PlayoffHandler{
+		logger:         logger.NewLogger(),
+		playoffService: playoffService,
+	}
+}
+
+// CreatePlayoffs handles POST /seasons/:id/playoffs
+func (h *PlayoffHandler) CreatePlayoffs(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid season ID"})
+		return
+	}
+
+	var opts services.PlayoffOptions
+	if err := c.ShouldBindJSON(&opts); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.logger.Info("Creating %d-team playoffs for season ID: %d", opts.Teams, uint(id))
+	matches, err := h.playoffService.CreatePlayoffs(uint(id), opts)
+	if err != nil {
+		h.logger.Error("Failed to create playoffs for season ID %d: %v", uint(id), err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, matches)
+}
+
+// GetBracket handles GET /seasons/:id/bracket
+func (h *PlayoffHandler) GetBracket(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid season ID"})
+		return
+	}
+
+	bracket, err := h.playoffService.GetBracket(uint(id))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, bracket)
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"catalyst-players/internal/application/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PredictionHandler handles HTTP requests for match outcome predictions
+type PredictionHandler struct {
+	predictionService *services.PredictionService
+}
+
+// NewPredictionHandler creates a new prediction handler
+func NewPredictionHandler(predictionService *services.PredictionService) *PredictionHandler {
+	return &PredictionHandler{
+		predictionService: predictionService,
+	}
+}
+
+// GetPrediction handles GET /matches/:id/prediction
+func (h *PredictionHandler) GetPrediction(c *gin.Context) {
+	matchID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid match ID"})
+		return
+	}
+
+	prediction, err := h.predictionService.PredictMatch(uint(matchID))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, prediction)
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"catalyst-players/internal/application/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RatingHandler handles HTTP requests for team strength ratings
+type RatingHandler struct {
+	ratingService *services.RatingService
+}
+
+// NewRatingHandler creates a new rating handler
+func NewRatingHandler(ratingService *services.RatingService) *RatingHandler {
+	return &RatingHandler{
+		ratingService: ratingService,
+	}
+}
+
+// GetTeamHistory handles GET /teams/:id/rating-history
+func (h *RatingHandler) GetTeamHistory(c *gin.Context) {
+	teamID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid team ID"})
+		return
+	}
+
+	history, err := h.ratingService.GetTeamHistory(uint(teamID))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, history)
+}
+
+// GetRankings handles GET /ratings with an optional league_id
+func (h *RatingHandler) GetRankings(c *gin.Context) {
+	var leagueID uint64
+	if leagueIDStr := c.Query("league_id"); leagueIDStr != "" {
+		var err error
+		leagueID, err = strconv.ParseUint(leagueIDStr, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid league ID"})
+			return
+		}
+	}
+
+	rankings, err := h.ratingService.GetRankings(uint(leagueID))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, rankings)
+}
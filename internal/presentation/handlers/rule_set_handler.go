@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"catalyst-players/internal/application/services"
+	"catalyst-players/internal/domain/entities"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RuleSetHandler handles HTTP requests for league and season rules
+type RuleSetHandler struct {
+	ruleSetService *services.RuleSetService
+}
+
+// NewRuleSetHandler creates a new rule set handler
+func NewRuleSetHandler(ruleSetService *services.RuleSetService) *RuleSetHandler {
+	return &RuleSetHandler{
+		ruleSetService: ruleSetService,
+	}
+}
+
+// GetLeagueRules handles GET /leagues/:id/rules
+func (h *RuleSetHandler) GetLeagueRules(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid league ID"})
+		return
+	}
+
+	ruleSet, err := h.ruleSetService.GetLeagueRules(uint(id))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, ruleSet)
+}
+
+// UpdateLeagueRules handles PUT /leagues/:id/rules
+func (h *RuleSetHandler) UpdateLeagueRules(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid league ID"})
+		return
+	}
+
+	var ruleSet entities.RuleSet
+	if err := c.ShouldBindJSON(&ruleSet); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.ruleSetService.SetLeagueRules(uint(id), &ruleSet); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, ruleSet)
+}
+
+// GetSeasonRules handles GET /seasons/:id/rules and returns the rules in effect for the season
+func (h *RuleSetHandler) GetSeasonRules(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid season ID"})
+		return
+	}
+
+	ruleSet, err := h.ruleSetService.ResolveForSeason(uint(id))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, ruleSet)
+}
+
+// UpdateSeasonRules handles PUT /seasons/:id/rules
+func (h *RuleSetHandler) UpdateSeasonRules(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid season ID"})
+		return
+	}
+
+	var ruleSet entities.RuleSet
+	if err := c.ShouldBindJSON(&ruleSet); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.ruleSetService.SetSeasonRules(uint(id), &ruleSet); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, ruleSet)
+}
+
+// DeleteSeasonRules handles DELETE /seasons/:id/rules
+func (h *RuleSetHandler) DeleteSeasonRules(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid season ID"})
+		return
+	}
+
+	if err := h.ruleSetService.DeleteSeasonRules(uint(id)); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Season rules deleted successfully"})
+}
@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/infrastructure/broker"
+	"catalyst-players/internal/infrastructure/logger"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+)
+
+// Scoreboard keep-alive: the server pings every interval and drops clients that stay silent for the timeout
+const (
+	scoreboardPingInterval = 30 * time.Second
+	scoreboardIdleTimeout  = 75 * time.Second
+)
+
+// Scoreboard actions a client can send
+const (
+	scoreboardActionSubscribe   = "subscribe"
+	scoreboardActionUnsubscribe = "unsubscribe"
+	scoreboardActionPing        = "ping"
+	scoreboardActionPong        = "pong"
+)
+
+// scoreboardRequest is a message sent by a scoreboard client
+type scoreboardRequest struct {
+	Action   string `json:"action"`
+	MatchID  uint   `json:"match_id"`
+	SeasonID uint   `json:"season_id"`
+}
+
+// scoreboardMessage is a message sent to a scoreboard client
+type scoreboardMessage struct {
+	Type     string                `json:"type"`
+	MatchID  uint                  `json:"match_id,omitempty"`
+	SeasonID uint                  `json:"season_id,omitempty"`
+	Update   *entities.MatchUpdate `json:"update,omitempty"`
+	Error    string                `json:"error,omitempty"`
+}
+
+// scoreboardSubscriptions is the set of matches and seasons a connection follows
+type scoreboardSubscriptions struct {
+	mu      sync.Mutex
+	matches map[uint]bool
+	seasons map[uint]bool
+}
+
+// newScoreboardSubscriptions creates an empty subscription set
+func newScoreboardSubscriptions() *scoreboardSubscriptions {
+	return &scoreboardSubscriptions{
+		matches: make(map[uint]bool),
+		seasons: make(map[uint]bool),
+	}
+}
+
+// accepts reports whether an update belongs to a followed match or season
+func (s *scoreboardSubscriptions) accepts(update entities.MatchUpdate) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.matches[update.MatchID] || s.seasons[update.SeasonID]
+}
+
+// apply adds or removes the match or season of a subscribe or unsubscribe request
+func (s *scoreboardSubscriptions) apply(request scoreboardRequest) error {
+	if (request.MatchID == 0) == (request.SeasonID == 0) {
+		return errors.New("exactly one of match_id or season_id is required")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	subscribed := request.Action == scoreboardActionSubscribe
+	if request.MatchID != 0 {
+		s.set(s.matches, request.MatchID, subscribed)
+	} else {
+		s.set(s.seasons, request.SeasonID, subscribed)
+	}
+	return nil
+}
+
+// set adds or removes an ID; the caller must hold the lock
+func (s *scoreboardSubscriptions) set(ids map[uint]bool, id uint, subscribed bool) {
+	if subscribed {
+		ids[id] = true
+		return
+	}
+	delete(ids, id)
+}
+
+// ScoreboardHandler serves the WebSocket feed used by stadium scoreboards. Clients subscribe to
+// matches and seasons and receive the same score, status and player statistics updates as the
+// live streams.
+type ScoreboardHandler struct {
+	logger       logger.Logger
+	broker       *broker.Broker
+	pingInterval time.Duration
+	idleTimeout  time.Duration
+}
+
+// NewScoreboardHandler creates a new scoreboard handler
+func NewScoreboardHandler(broker *broker.Broker) *ScoreboardHandler {
+	return &ScoreboardHandler{
+		logger:       logger.NewLogger(),
+		broker:       broker,
+		pingInterval: scoreboardPingInterval,
+		idleTimeout:  scoreboardIdleTimeout,
+	}
+}
+
+// Serve handles GET /scoreboard/ws and upgrades the request to a WebSocket connection
+func (h *ScoreboardHandler) Serve(c *gin.Context) {
+	server := websocket.Server{
+		// Displays connect from anywhere, like the rest of the API
+		Handshake: func(*websocket.Config, *http.Request) error { return nil },
+		Handler:   h.serveConn,
+	}
+	server.ServeHTTP(c.Writer, c.Request)
+}
+
+// serveConn forwards broker updates for the followed matches and seasons until the client
+// disconnects, goes quiet for longer than the idle timeout or falls too far behind
+func (h *ScoreboardHandler) serveConn(ws *websocket.Conn) {
+	defer ws.Close()
+
+	subscriptions := newScoreboardSubscriptions()
+	sub := h.broker.Subscribe(subscriptions.accepts)
+	defer h.broker.Unsubscribe(sub)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		h.readRequests(ws, subscriptions)
+	}()
+
+	ping := time.NewTicker(h.pingInterval)
+	defer ping.Stop()
+
+	for {
+		select {
+		case update, ok := <-sub.Updates():
+			if !ok {
+				websocket.JSON.Send(ws, scoreboardMessage{Type: "error", Error: "connection closed because the client fell too far behind"})
+				return
+			}
+			if err := websocket.JSON.Send(ws, scoreboardMessage{Type: "update", Update: &update}); err != nil {
+				return
+			}
+		case <-ping.C:
+			if err := websocket.JSON.Send(ws, scoreboardMessage{Type: scoreboardActionPing}); err != nil {
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// readRequests handles the messages of a client until the connection fails or goes idle
+func (h *ScoreboardHandler) readRequests(ws *websocket.Conn, subscriptions *scoreboardSubscriptions) {
+	for {
+		ws.SetReadDeadline(time.Now().Add(h.idleTimeout))
+
+		var request scoreboardRequest
+		if err := websocket.JSON.Receive(ws, &request); err != nil {
+			return
+		}
+
+		var reply scoreboardMessage
+		switch request.Action {
+		case scoreboardActionSubscribe, scoreboardActionUnsubscribe:
+			if err := subscriptions.apply(request); err != nil {
+				reply = scoreboardMessage{Type: "error", Error: err.Error()}
+				break
+			}
+			reply = scoreboardMessage{Type: "subscribed", MatchID: request.MatchID, SeasonID: request.SeasonID}
+			if request.Action == scoreboardActionUnsubscribe {
+				reply.Type = "unsubscribed"
+			}
+		case scoreboardActionPing:
+			reply = scoreboardMessage{Type: scoreboardActionPong}
+		case scoreboardActionPong:
+			continue
+		default:
+			reply = scoreboardMessage{Type: "error", Error: "unknown action " + request.Action}
+		}
+
+		if err := websocket.JSON.Send(ws, reply); err != nil {
+			h.logger.Error("Failed to reply to scoreboard client: %v", err)
+			return
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"catalyst-players/internal/domain/entities"
+	"catalyst-players/internal/infrastructure/broker"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+)
+
+// pipeListener is an in-memory net.Listener whose connections are created with net.Pipe
+type pipeListener struct {
+	conns  chan net.Conn
+	closed chan struct{}
+}
+
+// newPipeListener creates an in-memory listener
+func newPipeListener() *pipeListener {
+	return &pipeListener{conns: make(chan net.Conn), closed: make(chan struct{})}
+}
+
+func (l *pipeListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *pipeListener) Close() error {
+	close(l.closed)
+	return nil
+}
+
+func (l *pipeListener) Addr() net.Addr {
+	return &net.UnixAddr{Name: "scoreboard", Net: "pipe"}
+}
+
+// dial opens an in-memory connection to the server
+func (l *pipeListener) dial() net.Conn {
+	server, client := net.Pipe()
+	l.conns <- server
+	return client
+}
+
+// newScoreboardClient serves the scoreboard handler in memory and connects a WebSocket client to it
+func newScoreboardClient(t *testing.T, handler *ScoreboardHandler) *websocket.Conn {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/scoreboard/ws", handler.Serve)
+
+	listener := newPipeListener()
+	server := &http.Server{Handler: router}
+	go server.Serve(listener)
+	t.Cleanup(func() { server.Close() })
+
+	config, err := websocket.NewConfig("ws://scoreboard/scoreboard/ws", "http://scoreboard/")
+	if err != nil {
+		t.Fatalf("NewConfig() error = %v", err)
+	}
+	ws, err := websocket.NewClient(config, listener.dial())
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	t.Cleanup(func() { ws.Close() })
+	ws.SetDeadline(time.Now().Add(5 * time.Second))
+	return ws
+}
+
+// send writes a request to the scoreboard server
+func send(t *testing.T, ws *websocket.Conn, request scoreboardRequest) {
+	t.Helper()
+	if err := websocket.JSON.Send(ws, request); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+}
+
+// receive reads the next message from the scoreboard server and checks its type
+func receive(t *testing.T, ws *websocket.Conn, wantType string) scoreboardMessage {
+	t.Helper()
+	var message scoreboardMessage
+	if err := websocket.JSON.Receive(ws, &message); err != nil {
+		t.Fatalf("Receive() error = %v", err)
+	}
+	if message.Type != wantType {
+		t.Fatalf("received %q message %+v, want %q", message.Type, message, wantType)
+	}
+	return message
+}
+
+// TestScoreboardHandler_Subscriptions tests subscribing to matches and seasons over a WebSocket
+func TestScoreboardHandler_Subscriptions(t *testing.T) {
+	liveBroker := broker.NewBroker(broker.DefaultBufferSize)
+	ws := newScoreboardClient(t, NewScoreboardHandler(liveBroker))
+
+	send(t, ws, scoreboardRequest{Action: "subscribe", MatchID: 1})
+	receive(t, ws, "subscribed")
+	send(t, ws, scoreboardRequest{Action: "subscribe", SeasonID: 2})
+	receive(t, ws, "subscribed")
+
+	liveBroker.Publish(entities.MatchUpdate{Type: entities.MatchUpdateScore, MatchID: 1, SeasonID: 1})
+	liveBroker.Publish(entities.MatchUpdate{Type: entities.MatchUpdateStatus, MatchID: 7, SeasonID: 3})
+	liveBroker.Publish(entities.MatchUpdate{Type: entities.MatchUpdateStatus, MatchID: 5, SeasonID: 2})
+
+	if update := receive(t, ws, "update").Update; update.MatchID != 1 || update.Type != entities.MatchUpdateScore {
+		t.Errorf("first update = %+v, want the score of match 1", update)
+	}
+	if update := receive(t, ws, "update").Update; update.MatchID != 5 {
+		t.Errorf("second update is for match %d, want match 5 of season 2", update.MatchID)
+	}
+
+	send(t, ws, scoreboardRequest{Action: "ping"})
+	receive(t, ws, "pong")
+
+	send(t, ws, scoreboardRequest{Action: "unsubscribe", MatchID: 1})
+	receive(t, ws, "unsubscribed")
+	liveBroker.Publish(entities.MatchUpdate{Type: entities.MatchUpdateScore, MatchID: 1, SeasonID: 1})
+	liveBroker.Publish(entities.MatchUpdate{Type: entities.MatchUpdateScore, MatchID: 6, SeasonID: 2})
+	if update := receive(t, ws, "update").Update; update.MatchID != 6 {
+		t.Errorf("update after unsubscribe is for match %d, want match 6", update.MatchID)
+	}
+
+	send(t, ws, scoreboardRequest{Action: "subscribe", MatchID: 1, SeasonID: 2})
+	receive(t, ws, "error")
+	send(t, ws, scoreboardRequest{Action: "shout"})
+	receive(t, ws, "error")
+}
+
+// TestScoreboardHandler_KeepAlive tests the server pings and the idle timeout of silent clients
+func TestScoreboardHandler_KeepAlive(t *testing.T) {
+	liveBroker := broker.NewBroker(broker.DefaultBufferSize)
+	handler := NewScoreboardHandler(liveBroker)
+	handler.pingInterval = 20 * time.Millisecond
+	handler.idleTimeout = 100 * time.Millisecond
+	ws := newScoreboardClient(t, handler)
+
+	receive(t, ws, "ping")
+	send(t, ws, scoreboardRequest{Action: "pong"})
+
+	// Without further messages the server drops the connection after the idle timeout
+	for {
+		var message scoreboardMessage
+		if err := websocket.JSON.Receive(ws, &message); err != nil {
+			break
+		}
+		if message.Type != "ping" {
+			t.Fatalf("received %q message while idle, want only pings", message.Type)
+		}
+	}
+	if liveBroker.Subscribers() != 0 {
+		t.Errorf("broker still has %d subscribers after the connection closed", liveBroker.Subscribers())
+	}
+}
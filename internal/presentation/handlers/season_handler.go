@@ -221,3 +221,26 @@ func (h *SeasonHandler) CompleteSeason(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{"message": "Season completed successfully"})
 }
+
+// RolloverSeason handles POST /seasons/:id/rollover
+func (h *SeasonHandler) RolloverSeason(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid season ID"})
+		return
+	}
+
+	var opts services.RolloverOptions
+	if err := c.ShouldBindJSON(&opts); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	season, matches, err := h.seasonService.RolloverSeason(uint(id), opts)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"season": season, "matches": matches})
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"catalyst-players/internal/application/services"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SeasonTeamHandler handles HTTP requests for the teams enrolled in a season
+type SeasonTeamHandler struct {
+	seasonTeamService *services.SeasonTeamService
+}
+
+// NewSeasonTeamHandler creates a new season team handler
+func NewSeasonTeamHandler(seasonTeamService *services.SeasonTeamService) *SeasonTeamHandler {
+	return &SeasonTeamHandler{
+		seasonTeamService: seasonTeamService,
+	}
+}
+
+// AddTeam handles POST /seasons/:id/teams
+func (h *SeasonTeamHandler) AddTeam(c *gin.Context) {
+	seasonID, force, ok := h.parseRequest(c)
+	if !ok {
+		return
+	}
+
+	var request struct {
+		TeamID uint `json:"team_id" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	season, err := h.seasonTeamService.AddTeam(seasonID, request.TeamID, force)
+	if err != nil {
+		h.writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, season)
+}
+
+// ReplaceTeams handles PUT /seasons/:id/teams
+func (h *SeasonTeamHandler) ReplaceTeams(c *gin.Context) {
+	seasonID, force, ok := h.parseRequest(c)
+	if !ok {
+		return
+	}
+
+	var request struct {
+		TeamIDs []uint `json:"team_ids"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	season, err := h.seasonTeamService.ReplaceTeams(seasonID, request.TeamIDs, force)
+	if err != nil {
+		h.writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, season)
+}
+
+// RemoveTeam handles DELETE /seasons/:id/teams/:teamId
+func (h *SeasonTeamHandler) RemoveTeam(c *gin.Context) {
+	seasonID, force, ok := h.parseRequest(c)
+	if !ok {
+		return
+	}
+
+	teamID, err := strconv.ParseUint(c.Param("teamId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid team ID"})
+		return
+	}
+
+	if err := h.seasonTeamService.RemoveTeam(seasonID, uint(teamID), force); err != nil {
+		h.writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Team removed from season successfully"})
+}
+
+// parseRequest reads the season ID and the optional force query parameter
+func (h *SeasonTeamHandler) parseRequest(c *gin.Context) (uint, bool, bool) {
+	seasonID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid season ID"})
+		return 0, false, false
+	}
+
+	force, err := strconv.ParseBool(c.DefaultQuery("force", "false"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid force parameter"})
+		return 0, false, false
+	}
+
+	return uint(seasonID), force, true
+}
+
+// writeError responds with 409 for changes blocked by the season rules and 500 otherwise
+func (h *SeasonTeamHandler) writeError(c *gin.Context, err error) {
+	if errors.Is(err, services.ErrSeasonTeamsLocked) || errors.Is(err, services.ErrTeamHasMatches) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"catalyst-players/internal/application/services"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SimulationHandler handles HTTP requests for season simulations
+type SimulationHandler struct {
+	simulationService *services.SimulationService
+}
+
+// NewSimulationHandler creates a new simulation handler
+func NewSimulationHandler(simulationService *services.SimulationService) *SimulationHandler {
+	return &SimulationHandler{
+		simulationService: simulationService,
+	}
+}
+
+// SimulateSeason handles GET /seasons/:id/simulation with optional runs and seed
+func (h *SimulationHandler) SimulateSeason(c *gin.Context) {
+	seasonID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid season ID"})
+		return
+	}
+
+	var opts services.SimulationOptions
+	if runsStr := c.Query("runs"); runsStr != "" {
+		opts.Runs, err = strconv.Atoi(runsStr)
+		if err != nil || opts.Runs < 1 || opts.Runs > services.MaxSimulationRuns {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Invalid runs. Use a number between 1 and %d", services.MaxSimulationRuns)})
+			return
+		}
+	}
+
+	if seedStr := c.Query("seed"); seedStr != "" {
+		opts.RandomSeed, err = strconv.ParseInt(seedStr, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid seed"})
+			return
+		}
+	}
+
+	simulation, err := h.simulationService.SimulateSeason(uint(seasonID), opts)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, simulation)
+}
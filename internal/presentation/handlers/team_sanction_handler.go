@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"catalyst-players/internal/application/services"
+	"catalyst-players/internal/domain/entities"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TeamSanctionHandler handles HTTP requests for points deducted from teams
+type TeamSanctionHandler struct {
+	teamSanctionService *services.TeamSanctionService
+}
+
+// NewTeamSanctionHandler creates a new team sanction handler
+func NewTeamSanctionHandler(teamSanctionService *services.TeamSanctionService) *TeamSanctionHandler {
+	return &TeamSanctionHandler{
+		teamSanctionService: teamSanctionService,
+	}
+}
+
+// CreateSanction handles POST /seasons/:id/sanctions
+func (h *TeamSanctionHandler) CreateSanction(c *gin.Context) {
+	seasonID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid season ID"})
+		return
+	}
+
+	var sanction entities.TeamSanction
+	if err := c.ShouldBindJSON(&sanction); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.teamSanctionService.CreateSanction(uint(seasonID), &sanction); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, sanction)
+}
+
+// GetSanctions handles GET /seasons/:id/sanctions
+func (h *TeamSanctionHandler) GetSanctions(c *gin.Context) {
+	seasonID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid season ID"})
+		return
+	}
+
+	sanctions, err := h.teamSanctionService.GetSanctions(uint(seasonID))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, sanctions)
+}
+
+// GetSanction handles GET /seasons/:id/sanctions/:sanctionId
+func (h *TeamSanctionHandler) GetSanction(c *gin.Context) {
+	seasonID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid season ID"})
+		return
+	}
+
+	sanctionID, err := strconv.ParseUint(c.Param("sanctionId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid team sanction ID"})
+		return
+	}
+
+	sanction, err := h.teamSanctionService.GetSanction(uint(seasonID), uint(sanctionID))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, sanction)
+}
+
+// UpdateSanction handles PUT /seasons/:id/sanctions/:sanctionId
+func (h *TeamSanctionHandler) UpdateSanction(c *gin.Context) {
+	seasonID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid season ID"})
+		return
+	}
+
+	sanctionID, err := strconv.ParseUint(c.Param("sanctionId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid team sanction ID"})
+		return
+	}
+
+	var sanction entities.TeamSanction
+	if err := c.ShouldBindJSON(&sanction); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	sanction.ID = uint(sanctionID)
+	if err := h.teamSanctionService.UpdateSanction(uint(seasonID), &sanction); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, sanction)
+}
+
+// DeleteSanction handles DELETE /seasons/:id/sanctions/:sanctionId
+func (h *TeamSanctionHandler) DeleteSanction(c *gin.Context) {
+	seasonID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid season ID"})
+		return
+	}
+
+	sanctionID, err := strconv.ParseUint(c.Param("sanctionId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid team sanction ID"})
+		return
+	}
+
+	if err := h.teamSanctionService.DeleteSanction(uint(seasonID), uint(sanctionID)); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Team sanction deleted successfully"})
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"catalyst-players/internal/application/services"
+	"catalyst-players/internal/domain/entities"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TieHandler handles HTTP requests for two-legged knockout ties
+type TieHandler struct {
+	tieService *services.TieService
+}
+
+// NewTieHandler creates a new tie handler
+func NewTieHandler(tieService *services.TieService) *TieHandler {
+	return &TieHandler{
+		tieService: tieService,
+	}
+}
+
+// CreateTie handles POST /ties
+func (h *TieHandler) CreateTie(c *gin.Context) {
+	var tie entities.Tie
+	if err := c.ShouldBindJSON(&tie); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.tieService.CreateTie(&tie)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// GetTie handles GET /ties/:id
+func (h *TieHandler) GetTie(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tie ID"})
+		return
+	}
+
+	result, err := h.tieService.GetTie(uint(id))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Tie not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// DeleteTie handles DELETE /ties/:id
+func (h *TieHandler) DeleteTie(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tie ID"})
+		return
+	}
+
+	if err := h.tieService.DeleteTie(uint(id)); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Tie deleted successfully"})
+}
+
+// GetSeasonTies handles GET /seasons/:id/ties
+func (h *TieHandler) GetSeasonTies(c *gin.Context) {
+	seasonID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid season ID"})
+		return
+	}
+
+	results, err := h.tieService.GetSeasonTies(uint(seasonID))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, results)
+}
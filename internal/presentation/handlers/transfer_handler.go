@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"catalyst-players/internal/application/services"
+	"catalyst-players/internal/domain/entities"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TransferHandler handles HTTP requests for player transfers and transfer windows
+type TransferHandler struct {
+	transferService *services.TransferService
+}
+
+// NewTransferHandler creates a new transfer handler
+func NewTransferHandler(transferService *services.TransferService) *TransferHandler {
+	return &TransferHandler{
+		transferService: transferService,
+	}
+}
+
+// Transfer handles POST /players/:id/transfer
+func (h *TransferHandler) Transfer(c *gin.Context) {
+	playerID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid player ID"})
+		return
+	}
+
+	var request struct {
+		TeamID      uint      `json:"team_id" binding:"required"`
+		SeasonID    uint      `json:"season_id" binding:"required"`
+		Date        time.Time `json:"date"`
+		ShirtNumber int       `json:"shirt_number"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	registration, err := h.transferService.Transfer(uint(playerID), request.TeamID, request.SeasonID, request.Date, request.ShirtNumber)
+	if err != nil {
+		if errors.Is(err, services.ErrTransferWindowClosed) {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, registration)
+}
+
+// GetCareer handles GET /players/:id/career
+func (h *TransferHandler) GetCareer(c *gin.Context) {
+	playerID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid player ID"})
+		return
+	}
+
+	registrations, err := h.transferService.GetCareer(uint(playerID))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, registrations)
+}
+
+// GetTransferWindows handles GET /seasons/:id/transfer-windows
+func (h *TransferHandler) GetTransferWindows(c *gin.Context) {
+	seasonID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid season ID"})
+		return
+	}
+
+	windows, err := h.transferService.GetTransferWindows(uint(seasonID))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, windows)
+}
+
+// CreateTransferWindow handles POST /seasons/:id/transfer-windows
+func (h *TransferHandler) CreateTransferWindow(c *gin.Context) {
+	seasonID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid season ID"})
+		return
+	}
+
+	var window entities.TransferWindow
+	if err := c.ShouldBindJSON(&window); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.transferService.CreateTransferWindow(uint(seasonID), &window); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, window)
+}
+
+// DeleteTransferWindow handles DELETE /seasons/:id/transfer-windows/:windowId
+func (h *TransferHandler) DeleteTransferWindow(c *gin.Context) {
+	seasonID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid season ID"})
+		return
+	}
+
+	windowID, err := strconv.ParseUint(c.Param("windowId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid transfer window ID"})
+		return
+	}
+
+	if err := h.transferService.DeleteTransferWindow(uint(seasonID), uint(windowID)); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Transfer window deleted successfully"})
+}
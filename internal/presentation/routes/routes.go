@@ -2,16 +2,20 @@ package routes
 
 import (
 	"catalyst-players/internal/application/services"
+	"catalyst-players/internal/infrastructure/broker"
 	"catalyst-players/internal/infrastructure/repositories"
 	"catalyst-players/internal/presentation/handlers"
+	"net/http"
+	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"gorm.io/gorm"
 )
 
-// SetupRoutes configures the application's routes
-func SetupRoutes(db *gorm.DB) *gin.Engine {
+// SetupRoutes configures the application's routes. It also returns the webhook service,
+// whose delivery worker the caller runs for the lifetime of the server.
+func SetupRoutes(db *gorm.DB) (*gin.Engine, *services.WebhookService) {
 	// Initialize repositories
 	stadiumRepo := repositories.NewStadiumRepositoryImpl(db)
 	teamRepo := repositories.NewTeamRepositoryImpl(db)
@@ -21,17 +25,65 @@ func SetupRoutes(db *gorm.DB) *gin.Engine {
 	seasonRepo := repositories.NewSeasonRepositoryImpl(db)
 	leagueRepo := repositories.NewLeagueRepositoryImpl(db)
 	playerRepo := repositories.NewPlayerRepositoryImpl(db)
+	ruleSetRepo := repositories.NewRuleSetRepositoryImpl(db)
+	matchEventRepo := repositories.NewMatchEventRepositoryImpl(db)
+	webhookSubscriptionRepo := repositories.NewWebhookSubscriptionRepositoryImpl(db)
+	webhookDeliveryRepo := repositories.NewWebhookDeliveryRepositoryImpl(db)
+	playerRegistrationRepo := repositories.NewPlayerRegistrationRepositoryImpl(db)
+	transferWindowRepo := repositories.NewTransferWindowRepositoryImpl(db)
+	promotionRuleRepo := repositories.NewPromotionRuleRepositoryImpl(db)
+	teamMovementRepo := repositories.NewTeamMovementRepositoryImpl(db)
+	groupRepo := repositories.NewGroupRepositoryImpl(db)
+	tieRepo := repositories.NewTieRepositoryImpl(db)
+	administrativeDecisionRepo := repositories.NewAdministrativeDecisionRepositoryImpl(db)
+	teamSanctionRepo := repositories.NewTeamSanctionRepositoryImpl(db)
+	teamRatingRepo := repositories.NewTeamRatingRepositoryImpl(db)
 
 	// Initialize services
+	ruleSetService := services.NewRuleSetService(ruleSetRepo, seasonRepo)
 	stadiumService := services.NewStadiumService(stadiumRepo)
 	teamService := services.NewTeamService(teamRepo)
 	tagService := services.NewTagService(tagRepo)
-	matchService := services.NewMatchService(matchRepo)
-	matchPlayerService := services.NewMatchPlayerService(matchPlayerRepo)
-	seasonService := services.NewSeasonService(seasonRepo)
+	matchService := services.NewMatchService(matchRepo, ruleSetService)
+	disciplineService := services.NewDisciplineService(matchRepo, matchPlayerRepo, ruleSetService)
+	seasonService := services.NewSeasonService(seasonRepo, ruleSetRepo, matchRepo, teamMovementRepo)
+	seasonTeamService := services.NewSeasonTeamService(seasonRepo, teamRepo, matchRepo)
 	leagueService := services.NewLeagueService(leagueRepo)
-	playerService := services.NewPlayerService(playerRepo)
-	leaderboardService := services.NewLeaderboardService(matchRepo) // Correct dependency
+	leaderboardService := services.NewLeaderboardService(matchRepo, matchPlayerRepo, ruleSetService, teamSanctionRepo)
+	fixtureService := services.NewFixtureService(seasonRepo, matchRepo, groupRepo)
+	groupService := services.NewGroupService(groupRepo, seasonRepo, matchRepo, leaderboardService)
+	tieService := services.NewTieService(tieRepo, matchRepo)
+	playoffService := services.NewPlayoffService(matchRepo, leaderboardService, groupService, tieService)
+	promotionService := services.NewPromotionService(promotionRuleRepo, teamMovementRepo, leagueRepo, matchRepo, leaderboardService)
+	matchLifecycleService := services.NewMatchLifecycleService(matchRepo, matchService)
+	teamSanctionService := services.NewTeamSanctionService(teamSanctionRepo, seasonRepo, matchRepo)
+	administrativeResultService := services.NewAdministrativeResultService(matchRepo, administrativeDecisionRepo, matchService, ruleSetService)
+	transferService := services.NewTransferService(playerRegistrationRepo, transferWindowRepo, playerRepo, seasonRepo)
+	playerService := services.NewPlayerService(playerRepo, transferService)
+	matchPlayerService := services.NewMatchPlayerService(matchPlayerRepo, matchRepo, seasonRepo, transferService, disciplineService)
+	matchEventService := services.NewMatchEventService(matchEventRepo, matchRepo, matchService, matchPlayerService)
+	ratingService := services.NewRatingService(teamRatingRepo, matchRepo, seasonRepo, teamRepo, services.RatingConfigFromEnv())
+	predictionService := services.NewPredictionService(matchRepo)
+	simulationService := services.NewSimulationService(leaderboardService, matchRepo)
+	webhookService := services.NewWebhookService(webhookSubscriptionRepo, webhookDeliveryRepo, &http.Client{Timeout: 10 * time.Second}, services.DefaultWebhookRetryPolicy())
+
+	// Knockout matches advance the bracket as soon as they are finished
+	matchService.OnMatchFinished(playoffService.AdvanceBracket)
+
+	// Team ratings are updated with every finished match
+	matchService.OnMatchFinished(ratingService.MatchFinished)
+
+	// Promotions and relegations are computed from the final standings of completed seasons
+	seasonService.OnSeasonCompleted(promotionService.SeasonCompleted)
+
+	// Live match updates are pushed to stream subscribers through an in-process broker
+	liveBroker := broker.NewBroker(broker.DefaultBufferSize)
+	matchService.OnMatchUpdated(liveBroker.Publish)
+	matchPlayerService.OnStatsUpdated(liveBroker.Publish)
+
+	// Partner webhooks are queued by the hooks and delivered by a background worker
+	matchService.OnMatchFinished(webhookService.MatchFinished)
+	seasonService.OnSeasonActivated(webhookService.SeasonActivated)
 
 	// Initialize handlers
 	stadiumHandler := handlers.NewStadiumHandler(stadiumService)
@@ -40,9 +92,28 @@ func SetupRoutes(db *gorm.DB) *gin.Engine {
 	matchHandler := handlers.NewMatchHandler(matchService)
 	matchPlayerHandler := handlers.NewMatchPlayerHandler(matchPlayerService)
 	seasonHandler := handlers.NewSeasonHandler(seasonService)
+	seasonTeamHandler := handlers.NewSeasonTeamHandler(seasonTeamService)
 	leagueHandler := handlers.NewLeagueHandler(leagueService)
 	playerHandler := handlers.NewPlayerHandler(playerService)
 	leaderboardHandler := handlers.NewLeaderboardHandler(leaderboardService)
+	fixtureHandler := handlers.NewFixtureHandler(fixtureService)
+	playoffHandler := handlers.NewPlayoffHandler(playoffService)
+	ruleSetHandler := handlers.NewRuleSetHandler(ruleSetService)
+	matchEventHandler := handlers.NewMatchEventHandler(matchEventService)
+	matchLifecycleHandler := handlers.NewMatchLifecycleHandler(matchLifecycleService)
+	administrativeResultHandler := handlers.NewAdministrativeResultHandler(administrativeResultService)
+	teamSanctionHandler := handlers.NewTeamSanctionHandler(teamSanctionService)
+	ratingHandler := handlers.NewRatingHandler(ratingService)
+	predictionHandler := handlers.NewPredictionHandler(predictionService)
+	simulationHandler := handlers.NewSimulationHandler(simulationService)
+	matchStreamHandler := handlers.NewMatchStreamHandler(matchService, liveBroker)
+	scoreboardHandler := handlers.NewScoreboardHandler(liveBroker)
+	webhookHandler := handlers.NewWebhookHandler(webhookService)
+	disciplineHandler := handlers.NewDisciplineHandler(disciplineService)
+	transferHandler := handlers.NewTransferHandler(transferService)
+	promotionHandler := handlers.NewPromotionHandler(promotionService)
+	groupHandler := handlers.NewGroupHandler(groupService)
+	tieHandler := handlers.NewTieHandler(tieService)
 
 	router := gin.Default()
 
@@ -92,6 +163,7 @@ func SetupRoutes(db *gorm.DB) *gin.Engine {
 			teams.DELETE("/:id", teamHandler.DeleteTeam)
 			teams.GET("/:id/matches", matchHandler.GetMatchesByTeamID)
 			teams.GET("/:id/match-stats", matchPlayerHandler.GetMatchPlayersByTeamID)
+			teams.GET("/:id/rating-history", ratingHandler.GetTeamHistory)
 		}
 
 		// Players routes
@@ -106,6 +178,9 @@ func SetupRoutes(db *gorm.DB) *gin.Engine {
 			players.GET("/:id/match-stats", matchPlayerHandler.GetMatchPlayersByPlayerID)
 			players.GET("/:id/stats/:season_id", matchPlayerHandler.GetPlayerStats)
 			players.GET("/:id/tags", tagHandler.GetTagsByPlayerID)
+			players.GET("/:id/suspensions", disciplineHandler.GetPlayerSuspensions)
+			players.POST("/:id/transfer", transferHandler.Transfer)
+			players.GET("/:id/career", transferHandler.GetCareer)
 		}
 
 		// Leagues routes
@@ -115,6 +190,11 @@ func SetupRoutes(db *gorm.DB) *gin.Engine {
 			leagues.GET("", leagueHandler.GetAllLeagues)
 			leagues.GET("/:id", leagueHandler.GetLeague)
 			leagues.GET("/:id/seasons", seasonHandler.GetSeasonsByLeagueID)
+			leagues.GET("/:id/rules", ruleSetHandler.GetLeagueRules)
+			leagues.PUT("/:id/rules", ruleSetHandler.UpdateLeagueRules)
+			leagues.GET("/:id/promotion", promotionHandler.GetPromotionRule)
+			leagues.PUT("/:id/promotion", promotionHandler.UpdatePromotionRule)
+			leagues.DELETE("/:id/promotion", promotionHandler.DeletePromotionRule)
 			leagues.PUT("/:id", leagueHandler.UpdateLeague)
 			leagues.DELETE("/:id", leagueHandler.DeleteLeague)
 		}
@@ -129,12 +209,37 @@ func SetupRoutes(db *gorm.DB) *gin.Engine {
 			seasonsGroup.GET("/:id", seasonHandler.GetSeason)
 			seasonsGroup.GET("/:id/league", seasonHandler.GetSeasonWithLeague)
 			seasonsGroup.GET("/:id/teams", seasonHandler.GetSeasonWithTeams)
+			seasonsGroup.POST("/:id/teams", seasonTeamHandler.AddTeam)
+			seasonsGroup.PUT("/:id/teams", seasonTeamHandler.ReplaceTeams)
+			seasonsGroup.DELETE("/:id/teams/:teamId", seasonTeamHandler.RemoveTeam)
 			seasonsGroup.GET("/:id/matches", matchHandler.GetMatchesBySeasonID)
 			seasonsGroup.GET("/:id/standings", teamHandler.GetTeamStandings)
+			seasonsGroup.GET("/:id/simulation", simulationHandler.SimulateSeason)
 			seasonsGroup.GET("/:id/top-scorers", playerHandler.GetTopScorers)
+			seasonsGroup.GET("/:id/suspensions", disciplineHandler.GetSeasonSuspensions)
+			seasonsGroup.POST("/:id/fixtures/generate", fixtureHandler.GenerateFixtures)
+			seasonsGroup.GET("/:id/groups", groupHandler.GetGroups)
+			seasonsGroup.POST("/:id/groups", groupHandler.CreateGroup)
+			seasonsGroup.POST("/:id/groups/draw", groupHandler.DrawGroups)
+			seasonsGroup.POST("/:id/playoffs", playoffHandler.CreatePlayoffs)
+			seasonsGroup.GET("/:id/bracket", playoffHandler.GetBracket)
+			seasonsGroup.GET("/:id/ties", tieHandler.GetSeasonTies)
+			seasonsGroup.GET("/:id/rules", ruleSetHandler.GetSeasonRules)
+			seasonsGroup.PUT("/:id/rules", ruleSetHandler.UpdateSeasonRules)
+			seasonsGroup.DELETE("/:id/rules", ruleSetHandler.DeleteSeasonRules)
+			seasonsGroup.GET("/:id/transfer-windows", transferHandler.GetTransferWindows)
+			seasonsGroup.POST("/:id/transfer-windows", transferHandler.CreateTransferWindow)
+			seasonsGroup.DELETE("/:id/transfer-windows/:windowId", transferHandler.DeleteTransferWindow)
+			seasonsGroup.GET("/:id/sanctions", teamSanctionHandler.GetSanctions)
+			seasonsGroup.POST("/:id/sanctions", teamSanctionHandler.CreateSanction)
+			seasonsGroup.GET("/:id/sanctions/:sanctionId", teamSanctionHandler.GetSanction)
+			seasonsGroup.PUT("/:id/sanctions/:sanctionId", teamSanctionHandler.UpdateSanction)
+			seasonsGroup.DELETE("/:id/sanctions/:sanctionId", teamSanctionHandler.DeleteSanction)
 			seasonsGroup.PUT("/:id", seasonHandler.UpdateSeason)
 			seasonsGroup.PUT("/:id/activate", seasonHandler.ActivateSeason)
 			seasonsGroup.PUT("/:id/complete", seasonHandler.CompleteSeason)
+			seasonsGroup.POST("/:id/rollover", seasonHandler.RolloverSeason)
+			seasonsGroup.GET("/:id/movements", promotionHandler.GetMovements)
 			seasonsGroup.DELETE("/:id", seasonHandler.DeleteSeason)
 		}
 
@@ -142,6 +247,7 @@ func SetupRoutes(db *gorm.DB) *gin.Engine {
 		matchesGroup := apiV1.Group("/matches")
 		{
 			matchesGroup.GET("/upcoming", matchHandler.GetUpcoming)
+			matchesGroup.GET("/live/stream", matchStreamHandler.StreamLive)
 			matchesGroup.POST("", matchHandler.CreateMatch)
 			matchesGroup.GET("", matchHandler.GetAllMatches)
 			matchesGroup.GET("/date-range", matchHandler.GetMatchesByDateRange)
@@ -150,6 +256,21 @@ func SetupRoutes(db *gorm.DB) *gin.Engine {
 			matchesGroup.GET("/:id/players", matchPlayerHandler.GetMatchPlayersByMatchID)
 			matchesGroup.PUT("/:id", matchHandler.UpdateMatch)
 			matchesGroup.PUT("/:id/score", matchHandler.UpdateMatchScore)
+			matchesGroup.POST("/:id/start", matchLifecycleHandler.Start)
+			matchesGroup.POST("/:id/halftime", matchLifecycleHandler.HalfTime)
+			matchesGroup.POST("/:id/finish", matchLifecycleHandler.Finish)
+			matchesGroup.POST("/:id/postpone", matchLifecycleHandler.Postpone)
+			matchesGroup.POST("/:id/reschedule", matchLifecycleHandler.Reschedule)
+			matchesGroup.POST("/:id/cancel", matchLifecycleHandler.Cancel)
+			matchesGroup.POST("/:id/abandon", matchLifecycleHandler.Abandon)
+			matchesGroup.POST("/:id/administrative-result", administrativeResultHandler.ApplyResult)
+			matchesGroup.GET("/:id/administrative-decisions", administrativeResultHandler.GetDecisions)
+			matchesGroup.GET("/:id/prediction", predictionHandler.GetPrediction)
+			matchesGroup.GET("/:id/stream", matchStreamHandler.StreamMatch)
+			matchesGroup.GET("/:id/events", matchEventHandler.GetEvents)
+			matchesGroup.POST("/:id/events", matchEventHandler.CreateEvent)
+			matchesGroup.PUT("/:id/events/:eventId", matchEventHandler.UpdateEvent)
+			matchesGroup.DELETE("/:id/events/:eventId", matchEventHandler.DeleteEvent)
 			matchesGroup.DELETE("/:id", matchHandler.DeleteMatch)
 			// matches.GET("/:season_id/:stage", matchHandler.GetMatchesByStage) // <-- Removed to avoid conflict
 			// Now use: /api/v1/matches?season_id=...&stage=...
@@ -165,15 +286,52 @@ func SetupRoutes(db *gorm.DB) *gin.Engine {
 			matchPlayers.DELETE("/:id", matchPlayerHandler.DeleteMatchPlayer)
 		}
 
+		// Groups routes
+		groups := apiV1.Group("/groups")
+		{
+			groups.GET("/:id", groupHandler.GetGroup)
+			groups.PUT("/:id/teams", groupHandler.SetGroupTeams)
+			groups.DELETE("/:id", groupHandler.DeleteGroup)
+		}
+
+		// Two-legged ties routes
+		ties := apiV1.Group("/ties")
+		{
+			ties.POST("", tieHandler.CreateTie)
+			ties.GET("/:id", tieHandler.GetTie)
+			ties.DELETE("/:id", tieHandler.DeleteTie)
+		}
+
 		// Team tags routes
 		teams.GET("/:id/tags", tagHandler.GetTagsByTeamID)
 
+		// Webhook routes
+		webhooks := apiV1.Group("/webhooks")
+		{
+			webhooks.POST("", webhookHandler.CreateWebhook)
+			webhooks.GET("", webhookHandler.GetAllWebhooks)
+			webhooks.GET("/:id", webhookHandler.GetWebhook)
+			webhooks.GET("/:id/deliveries", webhookHandler.GetDeliveries)
+			webhooks.PUT("/:id", webhookHandler.UpdateWebhook)
+			webhooks.POST("/:id/secret", webhookHandler.RotateWebhookSecret)
+			webhooks.DELETE("/:id", webhookHandler.DeleteWebhook)
+		}
+
+		// Scoreboard WebSocket feed for stadium displays
+		apiV1.GET("/scoreboard/ws", scoreboardHandler.Serve)
+
 		// Leaderboard routes
 		leaderboardGroup := apiV1.Group("/leaderboards")
 		{
 			leaderboardGroup.GET("/season/:seasonId", leaderboardHandler.GetLeaderboard)
+			leaderboardGroup.GET("/season/:seasonId/history", leaderboardHandler.GetHistory)
+			leaderboardGroup.GET("/season/:seasonId/groups", groupHandler.GetSeasonGroupStandings)
+			leaderboardGroup.GET("/group/:groupId", groupHandler.GetGroupStandings)
 		}
+
+		// Team rating rankings
+		apiV1.GET("/ratings", ratingHandler.GetRankings)
 	}
 
-	return router
+	return router, webhookService
 }